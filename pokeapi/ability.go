@@ -0,0 +1,43 @@
+package pokeapi
+
+import (
+	"context"
+	"strings"
+)
+
+const EndpointAbility = "ability"
+
+func (c *Client) GetAbility(name string) (Ability, error) {
+	return c.GetAbilityContext(context.Background(), name)
+}
+
+func (c *Client) GetAbilityContext(ctx context.Context, name string) (Ability, error) {
+	return Get[Ability](ctx, c, EndpointAbility, name)
+}
+
+func (a Ability) ShortEffect(language string) string {
+	return strings.Join(strings.Fields(findEffect(a.EffectEntries, language, false)), " ")
+}
+
+func (a Ability) Effect(language string) string {
+	return strings.Join(strings.Fields(findEffect(a.EffectEntries, language, true)), " ")
+}
+
+type Ability struct {
+	ID                int                   `json:"id"`
+	Name              string                `json:"name"`
+	IsMainSeries      bool                  `json:"is_main_series"`
+	Generation        NamedAPIResource[any] `json:"generation"`
+	Names             []Name                `json:"names"`
+	EffectEntries     []VerboseEffect       `json:"effect_entries"`
+	FlavorTextEntries []struct {
+		FlavorText   string                `json:"flavor_text"`
+		Language     NamedAPIResource[any] `json:"language"`
+		VersionGroup NamedAPIResource[any] `json:"version_group"`
+	} `json:"flavor_text_entries"`
+	Pokemon []struct {
+		IsHidden bool                          `json:"is_hidden"`
+		Slot     int                           `json:"slot"`
+		Pokemon  NamedAPIResource[PokemonData] `json:"pokemon"`
+	} `json:"pokemon"`
+}
@@ -0,0 +1,70 @@
+package pokeapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestGetAbility(t *testing.T) {
+	var gotPath string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		w.Write([]byte(`{
+			"name": "static",
+			"effect_entries": [
+				{"effect": "Whenever a move makes contact with this Pokémon,\nthe move's user has a 30% chance of being paralyzed.", "short_effect": "Has a 30% chance of paralyzing attacking Pokémon on contact.", "language": {"name": "en"}},
+				{"effect": "Lähmt bei Berührung.", "short_effect": "Lähmt bei Berührung.", "language": {"name": "de"}}
+			],
+			"pokemon": [
+				{"is_hidden": false, "slot": 1, "pokemon": {"name": "pikachu", "url": "https://pokeapi.co/api/v2/pokemon/25/"}},
+				{"is_hidden": true, "slot": 3, "pokemon": {"name": "electrode", "url": "https://pokeapi.co/api/v2/pokemon/101/"}}
+			]
+		}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(WithBaseURL(server.URL))
+
+	ability, err := client.GetAbility("static")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if gotPath != "/ability/static/" {
+		t.Errorf("expected request to /ability/static/, got %s", gotPath)
+	}
+	if got := ability.ShortEffect("en"); got != "Has a 30% chance of paralyzing attacking Pokémon on contact." {
+		t.Errorf("unexpected short effect %q", got)
+	}
+	if got := ability.Effect("en"); got != "Whenever a move makes contact with this Pokémon, the move's user has a 30% chance of being paralyzed." {
+		t.Errorf("unexpected effect %q", got)
+	}
+	if got := ability.Effect("fr"); got != "" {
+		t.Errorf("expected no effect in a missing language, got %q", got)
+	}
+	if len(ability.Pokemon) != 2 || !ability.Pokemon[1].IsHidden || ability.Pokemon[1].Pokemon.Name != "electrode" {
+		t.Errorf("unexpected pokemon: %+v", ability.Pokemon)
+	}
+}
+
+func TestPokemonAbilities(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"name":"pikachu","abilities":[{"is_hidden":false,"slot":1,"ability":{"name":"static"}},{"is_hidden":true,"slot":3,"ability":{"name":"lightning-rod"}}]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(WithBaseURL(server.URL))
+
+	pokemon, err := client.GetPokemonToCatch("pikachu")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []PokemonAbility{{Name: "static", Slot: 1}, {Name: "lightning-rod", IsHidden: true, Slot: 3}}
+	if !reflect.DeepEqual(pokemon.Abilities, want) {
+		t.Errorf("unexpected abilities: %+v", pokemon.Abilities)
+	}
+}
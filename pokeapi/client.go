@@ -0,0 +1,192 @@
+package pokeapi
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	DefaultBaseURL   = "https://pokeapi.co/api/v2"
+	DefaultUserAgent = "pokedexcli (+https://github.com/tenmoses/pokedex.boot.dev)"
+)
+
+func NewClient(options ...Option) *Client {
+	client := &Client{
+		baseURL:     DefaultBaseURL,
+		httpClient:  http.DefaultClient,
+		userAgent:   DefaultUserAgent,
+		retryPolicy: DefaultRetryPolicy,
+	}
+
+	for _, option := range options {
+		option(client)
+	}
+
+	if client.timeout > 0 {
+		//Copy so the caller's http.Client is not modified
+		httpClient := *client.httpClient
+		httpClient.Timeout = client.timeout
+		client.httpClient = &httpClient
+	}
+
+	return client
+}
+
+type Client struct {
+	baseURL     string
+	httpClient  *http.Client
+	userAgent   string
+	timeout     time.Duration
+	retryPolicy RetryPolicy
+	debugLogger *log.Logger
+	rateLimiter *RateLimiter
+}
+
+type Option func(*Client)
+
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) {
+		c.baseURL = strings.TrimRight(baseURL, "/")
+	}
+}
+
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		if httpClient != nil {
+			c.httpClient = httpClient
+		}
+	}
+}
+
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) {
+		c.userAgent = userAgent
+	}
+}
+
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *Client) {
+		c.timeout = timeout
+	}
+}
+
+func WithDebugLogger(logger *log.Logger) Option {
+	return func(c *Client) {
+		c.debugLogger = logger
+	}
+}
+
+func (c *Client) RateLimiter() *RateLimiter {
+	return c.rateLimiter
+}
+
+func (c *Client) BaseURL() string {
+	return c.baseURL
+}
+
+func (c *Client) get(ctx context.Context, path string) ([]byte, error) {
+	return c.getURL(ctx, c.baseURL+path)
+}
+
+func (c *Client) getURL(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+
+	for attempt := 1; ; attempt++ {
+		err := c.rateLimiter.Wait(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		body, header, err := c.do(req)
+
+		if err == nil {
+			return body, nil
+		}
+
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
+		if attempt >= c.retryPolicy.MaxAttempts || !isIdempotent(req.Method) || !isRetryable(err) {
+			c.debugf("attempt %d/%d for %s failed: %v; giving up", attempt, c.retryPolicy.MaxAttempts, url, err)
+			return nil, err
+		}
+
+		delay, ok := parseRetryAfter(header.Get("Retry-After"), time.Now())
+
+		if !ok {
+			delay = c.retryPolicy.backoff(attempt)
+		} else if c.retryPolicy.MaxDelay > 0 && delay > c.retryPolicy.MaxDelay {
+			//Waiting longer than the policy allows would stall the command
+			c.debugf("attempt %d/%d for %s failed: %v; Retry-After of %s exceeds %s, giving up", attempt, c.retryPolicy.MaxAttempts, url, err, delay, c.retryPolicy.MaxDelay)
+			return nil, err
+		}
+
+		c.debugf("attempt %d/%d for %s failed: %v; retrying in %s", attempt, c.retryPolicy.MaxAttempts, url, err, delay)
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func (c *Client) do(req *http.Request) ([]byte, http.Header, error) {
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	body, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if res.StatusCode > 299 {
+		return nil, res.Header, &APIError{StatusCode: res.StatusCode, URL: req.URL.String(), Body: string(body)}
+	}
+	if err != nil {
+		return nil, res.Header, err
+	}
+
+	return body, res.Header, nil
+}
+
+func (c *Client) debugf(format string, args ...any) {
+	if c.debugLogger != nil {
+		c.debugLogger.Printf("pokeapi: "+format, args...)
+	}
+}
+
+func (c *Client) getJSON(ctx context.Context, path string, v any) error {
+	return c.getURLJSON(ctx, c.baseURL+path, v)
+}
+
+func (c *Client) getURLJSON(ctx context.Context, url string, v any) error {
+	body, err := c.getURL(ctx, url)
+
+	if err != nil {
+		return err
+	}
+
+	jsonErr := json.Unmarshal(body, v)
+
+	if jsonErr != nil {
+		return &DecodeError{URL: url, Err: jsonErr}
+	}
+
+	return nil
+}
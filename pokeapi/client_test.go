@@ -0,0 +1,90 @@
+package pokeapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestClientUsesBaseURLAndUserAgent(t *testing.T) {
+	var gotPath, gotUserAgent string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotUserAgent = r.Header.Get("User-Agent")
+		w.Write([]byte(`{"name":"pikachu","base_experience":112,"types":[{"slot":1,"type":{"name":"electric"}}]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(WithBaseURL(server.URL+"/api/v2/"), WithUserAgent("pokedex-test"))
+
+	pokemon, err := client.GetPokemonToCatch("pikachu")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if gotPath != "/api/v2/pokemon/pikachu/" {
+		t.Errorf("expected request to /api/v2/pokemon/pikachu/, got %s", gotPath)
+	}
+	if gotUserAgent != "pokedex-test" {
+		t.Errorf("expected user agent pokedex-test, got %s", gotUserAgent)
+	}
+	if pokemon.Name != "pikachu" || pokemon.BaseExperience != 112 {
+		t.Errorf("unexpected pokemon: %+v", pokemon)
+	}
+	if len(pokemon.Types) != 1 || pokemon.Types[0] != "electric" {
+		t.Errorf("unexpected types: %v", pokemon.Types)
+	}
+}
+
+func TestClientContextCancellation(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-release:
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	client := NewClient(WithBaseURL(server.URL))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err := client.GetPokemonsInAreaContext(ctx, "canalave-city-area", EncounterFilter{})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected context.DeadlineExceeded, got %v", err)
+	}
+}
+
+func TestGetLocationAreaNamesFollowsLinks(t *testing.T) {
+	var gotQuery string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotQuery = r.URL.RawQuery
+		w.Write([]byte(`{"count":3,"next":"http://mirror/location-area/?offset=2&limit=2","previous":null,"results":[{"name":"canalave-city-area"},{"name":"eterna-city-area"}]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(WithBaseURL(server.URL))
+
+	page, err := client.GetLocationAreaNames(2, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if gotQuery != "limit=2&offset=0" {
+		t.Errorf("unexpected query %s", gotQuery)
+	}
+	if page.Count != 3 || len(page.Names) != 2 || page.Names[1] != "eterna-city-area" {
+		t.Errorf("unexpected page: %+v", page)
+	}
+	if page.Next != "http://mirror/location-area/?offset=2&limit=2" || page.Previous != "" {
+		t.Errorf("unexpected links: next %q previous %q", page.Next, page.Previous)
+	}
+}
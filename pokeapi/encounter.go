@@ -0,0 +1,154 @@
+package pokeapi
+
+import (
+	"context"
+	"strings"
+)
+
+func (c *Client) GetPokemonsInArea(area string, filter EncounterFilter) ([]AreaEncounter, error) {
+	return c.GetPokemonsInAreaContext(context.Background(), area, filter)
+}
+
+func (c *Client) GetPokemonsInAreaContext(ctx context.Context, area string, filter EncounterFilter) ([]AreaEncounter, error) {
+	locationArea, err := Get[LocationArea](ctx, c, EndpointLocationArea, area)
+
+	if err != nil {
+		return nil, err
+	}
+
+	encounters := make([]AreaEncounter, 0)
+
+	for _, pokemonEncounter := range locationArea.PokemonEncounters {
+		encounters = appendEncounters(encounters, locationArea.Name, pokemonEncounter.Pokemon.Name, pokemonEncounter.VersionDetails, filter)
+	}
+
+	return encounters, nil
+}
+
+func (c *Client) GetPokemonEncounters(pokemon string, filter EncounterFilter) ([]AreaEncounter, error) {
+	return c.GetPokemonEncountersContext(context.Background(), pokemon, filter)
+}
+
+func (c *Client) GetPokemonEncountersContext(ctx context.Context, pokemon string, filter EncounterFilter) ([]AreaEncounter, error) {
+	pokemonData, err := Get[PokemonData](ctx, c, EndpointPokemon, pokemon)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return pokemonData.ResolveEncounters(ctx, c, filter)
+}
+
+// Follows the location_area_encounters link of already fetched Pokemon data
+func (p PokemonData) ResolveEncounters(ctx context.Context, client *Client, filter EncounterFilter) ([]AreaEncounter, error) {
+	locationAreaEncounters, err := GetByURL[[]LocationAreaEncounter](ctx, client, p.LocationAreaEncounters)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return flattenLocationAreaEncounters(p.Name, locationAreaEncounters, filter), nil
+}
+
+// Merges encounters that differ only in level range and chance, keeping the
+// order in which they first appear
+func GroupEncounters(encounters []AreaEncounter) []AreaEncounter {
+	grouped := make([]AreaEncounter, 0)
+	index := make(map[string]int)
+
+	for _, encounter := range encounters {
+		key := strings.Join([]string{encounter.Area, encounter.Pokemon, encounter.Version, encounter.Method, strings.Join(encounter.Conditions, ",")}, "|")
+
+		i, ok := index[key]
+
+		if !ok {
+			index[key] = len(grouped)
+			encounter.Conditions = append(encounter.Conditions[:0:0], encounter.Conditions...)
+			grouped = append(grouped, encounter)
+			continue
+		}
+
+		grouped[i].MinLevel = min(grouped[i].MinLevel, encounter.MinLevel)
+		grouped[i].MaxLevel = max(grouped[i].MaxLevel, encounter.MaxLevel)
+		grouped[i].Chance += encounter.Chance
+	}
+
+	return grouped
+}
+
+func flattenLocationAreaEncounters(pokemon string, locationAreaEncounters []LocationAreaEncounter, filter EncounterFilter) []AreaEncounter {
+	encounters := make([]AreaEncounter, 0)
+
+	for _, locationAreaEncounter := range locationAreaEncounters {
+		encounters = appendEncounters(encounters, locationAreaEncounter.LocationArea.Name, pokemon, locationAreaEncounter.VersionDetails, filter)
+	}
+
+	return encounters
+}
+
+func appendEncounters(encounters []AreaEncounter, area string, pokemon string, versionDetails []VersionEncounterDetail, filter EncounterFilter) []AreaEncounter {
+	for _, versionDetail := range versionDetails {
+		if filter.Version != "" && versionDetail.Version.Name != filter.Version {
+			continue
+		}
+
+		for _, detail := range versionDetail.EncounterDetails {
+			if filter.Method != "" && detail.Method.Name != filter.Method {
+				continue
+			}
+
+			conditions := make([]string, 0, len(detail.ConditionValues))
+			for _, condition := range detail.ConditionValues {
+				conditions = append(conditions, condition.Name)
+			}
+
+			encounters = append(encounters, AreaEncounter{
+				Area:       area,
+				Pokemon:    pokemon,
+				Version:    versionDetail.Version.Name,
+				Method:     detail.Method.Name,
+				MinLevel:   detail.MinLevel,
+				MaxLevel:   detail.MaxLevel,
+				Chance:     detail.Chance,
+				Conditions: conditions,
+			})
+		}
+	}
+
+	return encounters
+}
+
+type EncounterFilter struct {
+	Version string
+	Method  string
+}
+
+type AreaEncounter struct {
+	Area       string
+	Pokemon    string
+	Version    string
+	Method     string
+	MinLevel   int
+	MaxLevel   int
+	Chance     int
+	Conditions []string
+}
+
+type LocationAreaEncounter struct {
+	LocationArea   NamedAPIResource[LocationArea] `json:"location_area"`
+	VersionDetails []VersionEncounterDetail       `json:"version_details"`
+}
+
+type VersionEncounterDetail struct {
+	Version          NamedAPIResource[any] `json:"version"`
+	MaxChance        int                   `json:"max_chance"`
+	EncounterDetails []Encounter           `json:"encounter_details"`
+}
+
+type Encounter struct {
+	MinLevel        int                     `json:"min_level"`
+	MaxLevel        int                     `json:"max_level"`
+	ConditionValues []NamedAPIResource[any] `json:"condition_values"`
+	Chance          int                     `json:"chance"`
+	Method          NamedAPIResource[any]   `json:"method"`
+}
@@ -0,0 +1,138 @@
+package pokeapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+const viridianForest = `{
+	"name": "viridian-forest-area",
+	"pokemon_encounters": [
+		{
+			"pokemon": {"name": "caterpie"},
+			"version_details": [
+				{"version": {"name": "red"}, "max_chance": 50, "encounter_details": [
+					{"min_level": 3, "max_level": 3, "chance": 20, "condition_values": [], "method": {"name": "walk"}},
+					{"min_level": 5, "max_level": 5, "chance": 30, "condition_values": [], "method": {"name": "walk"}}
+				]},
+				{"version": {"name": "blue"}, "max_chance": 5, "encounter_details": [
+					{"min_level": 4, "max_level": 4, "chance": 5, "condition_values": [], "method": {"name": "walk"}}
+				]}
+			]
+		},
+		{
+			"pokemon": {"name": "pikachu"},
+			"version_details": [
+				{"version": {"name": "red"}, "max_chance": 5, "encounter_details": [
+					{"min_level": 3, "max_level": 5, "chance": 5, "condition_values": [{"name": "time-morning"}], "method": {"name": "walk"}}
+				]}
+			]
+		}
+	]
+}`
+
+func TestGetPokemonsInArea(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(viridianForest))
+	}))
+	defer server.Close()
+
+	client := NewClient(WithBaseURL(server.URL))
+
+	encounters, err := client.GetPokemonsInArea("viridian-forest-area", EncounterFilter{Version: "red", Method: "walk"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []AreaEncounter{
+		{Area: "viridian-forest-area", Pokemon: "caterpie", Version: "red", Method: "walk", MinLevel: 3, MaxLevel: 5, Chance: 50, Conditions: []string{}},
+		{Area: "viridian-forest-area", Pokemon: "pikachu", Version: "red", Method: "walk", MinLevel: 3, MaxLevel: 5, Chance: 5, Conditions: []string{"time-morning"}},
+	}
+
+	if got := GroupEncounters(encounters); !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected encounters:\n got %+v\nwant %+v", got, want)
+	}
+
+	all, err := client.GetPokemonsInArea("viridian-forest-area", EncounterFilter{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(all) != 4 {
+		t.Errorf("expected 4 unfiltered encounters, got %d", len(all))
+	}
+
+	surfing, err := client.GetPokemonsInArea("viridian-forest-area", EncounterFilter{Method: "surf"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(surfing) != 0 {
+		t.Errorf("expected no surf encounters, got %+v", surfing)
+	}
+}
+
+const pikachuEncounters = `[
+	{
+		"location_area": {"name": "viridian-forest-area"},
+		"version_details": [
+			{"version": {"name": "red"}, "max_chance": 5, "encounter_details": [
+				{"min_level": 3, "max_level": 5, "chance": 5, "condition_values": [], "method": {"name": "walk"}}
+			]}
+		]
+	},
+	{
+		"location_area": {"name": "kanto-power-plant-area"},
+		"version_details": [
+			{"version": {"name": "red"}, "max_chance": 25, "encounter_details": [
+				{"min_level": 20, "max_level": 24, "chance": 25, "condition_values": [], "method": {"name": "walk"}}
+			]},
+			{"version": {"name": "yellow"}, "max_chance": 10, "encounter_details": [
+				{"min_level": 33, "max_level": 36, "chance": 10, "condition_values": [], "method": {"name": "walk"}}
+			]}
+		]
+	}
+]`
+
+func TestPokemonEncounters(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/pokemon/pikachu/", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"name":"pikachu","location_area_encounters":"` + server.URL + `/pokemon/25/encounters"}`))
+	})
+	mux.HandleFunc("/pokemon/25/encounters", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(pikachuEncounters))
+	})
+
+	client := NewClient(WithBaseURL(server.URL))
+	ctx := context.Background()
+
+	want := []AreaEncounter{
+		{Area: "viridian-forest-area", Pokemon: "pikachu", Version: "red", Method: "walk", MinLevel: 3, MaxLevel: 5, Chance: 5, Conditions: []string{}},
+		{Area: "kanto-power-plant-area", Pokemon: "pikachu", Version: "red", Method: "walk", MinLevel: 20, MaxLevel: 24, Chance: 25, Conditions: []string{}},
+	}
+
+	encounters, err := client.GetPokemonEncounters("pikachu", EncounterFilter{Version: "red"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(encounters, want) {
+		t.Errorf("unexpected encounters:\n got %+v\nwant %+v", encounters, want)
+	}
+
+	pokemon, err := Get[PokemonData](ctx, client, EndpointPokemon, "pikachu")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	resolved, err := pokemon.ResolveEncounters(ctx, client, EncounterFilter{Version: "red"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(resolved, want) {
+		t.Errorf("unexpected resolved encounters:\n got %+v\nwant %+v", resolved, want)
+	}
+}
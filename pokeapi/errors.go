@@ -0,0 +1,47 @@
+package pokeapi
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+var (
+	ErrNotFound    = errors.New("pokeapi: resource not found")
+	ErrRateLimited = errors.New("pokeapi: rate limited")
+	ErrEmptyURL    = errors.New("pokeapi: resource has no URL")
+)
+
+type APIError struct {
+	StatusCode int
+	URL        string
+	Body       string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("pokeapi: %s returned status %d", e.URL, e.StatusCode)
+}
+
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	}
+
+	return false
+}
+
+type DecodeError struct {
+	URL string
+	Err error
+}
+
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("pokeapi: decoding response from %s: %v", e.URL, e.Err)
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,68 @@
+package pokeapi
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestErrors(t *testing.T) {
+	cases := []struct {
+		name   string
+		status int
+		body   string
+		check  func(err error) bool
+	}{
+		{
+			name:   "not found",
+			status: http.StatusNotFound,
+			body:   "Not Found",
+			check:  func(err error) bool { return errors.Is(err, ErrNotFound) },
+		},
+		{
+			name:   "rate limited",
+			status: http.StatusTooManyRequests,
+			body:   "Too Many Requests",
+			check:  func(err error) bool { return errors.Is(err, ErrRateLimited) },
+		},
+		{
+			name:   "server error",
+			status: http.StatusBadGateway,
+			body:   "Bad Gateway",
+			check: func(err error) bool {
+				var apiErr *APIError
+				return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusBadGateway && !errors.Is(err, ErrNotFound)
+			},
+		},
+		{
+			name:   "bad json",
+			status: http.StatusOK,
+			body:   "{not json",
+			check: func(err error) bool {
+				var decodeErr *DecodeError
+				return errors.As(err, &decodeErr)
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(c.status)
+				w.Write([]byte(c.body))
+			}))
+			defer server.Close()
+
+			client := NewClient(WithBaseURL(server.URL), WithRetryPolicy(RetryPolicy{MaxAttempts: 1}))
+
+			_, err := client.GetPokemonToCatch("missingno")
+			if err == nil {
+				t.Fatalf("expected an error")
+			}
+			if !c.check(err) {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,201 @@
+package pokeapi
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+const EndpointEvolutionChain = "evolution-chain"
+
+func (c *Client) GetEvolutionChain(id int) (EvolutionChain, error) {
+	return c.GetEvolutionChainContext(context.Background(), id)
+}
+
+func (c *Client) GetEvolutionChainContext(ctx context.Context, id int) (EvolutionChain, error) {
+	return Get[EvolutionChain](ctx, c, EndpointEvolutionChain, fmt.Sprint(id))
+}
+
+func (c *Client) GetEvolutionChainForSpecies(species string) (EvolutionChain, error) {
+	return c.GetEvolutionChainForSpeciesContext(context.Background(), species)
+}
+
+func (c *Client) GetEvolutionChainForSpeciesContext(ctx context.Context, species string) (EvolutionChain, error) {
+	pokemonSpecies, err := c.GetPokemonSpeciesContext(ctx, species)
+
+	if err != nil {
+		return EvolutionChain{}, err
+	}
+
+	return pokemonSpecies.EvolutionChain.Resolve(ctx, c)
+}
+
+func (e EvolutionChain) Find(species string) (ChainLink, bool) {
+	path := e.Chain.pathTo(species)
+
+	if len(path) == 0 {
+		return ChainLink{}, false
+	}
+
+	return path[len(path)-1], true
+}
+
+// Species the given one evolves from, starting at the root of the chain
+func (e EvolutionChain) Predecessors(species string) []string {
+	path := e.Chain.pathTo(species)
+	predecessors := make([]string, 0)
+
+	for i := 0; i < len(path)-1; i++ {
+		predecessors = append(predecessors, path[i].Species.Name)
+	}
+
+	return predecessors
+}
+
+// Direct evolutions of the given species, each with its own evolution details
+func (e EvolutionChain) Successors(species string) []ChainLink {
+	link, ok := e.Find(species)
+
+	if !ok {
+		return make([]ChainLink, 0)
+	}
+
+	return link.EvolvesTo
+}
+
+func (l ChainLink) pathTo(species string) []ChainLink {
+	if l.Species.Name == species {
+		return []ChainLink{l}
+	}
+
+	for _, next := range l.EvolvesTo {
+		path := next.pathTo(species)
+
+		if len(path) > 0 {
+			return append([]ChainLink{l}, path...)
+		}
+	}
+
+	return nil
+}
+
+func (d EvolutionDetail) String() string {
+	conditions := make([]string, 0)
+
+	switch d.Trigger.Name {
+	case "level-up":
+		if d.MinLevel != nil {
+			conditions = append(conditions, fmt.Sprintf("level %d", *d.MinLevel))
+		} else {
+			conditions = append(conditions, "level up")
+		}
+	case "use-item":
+		if d.Item != nil {
+			conditions = append(conditions, fmt.Sprintf("use %s", d.Item.Name))
+		} else {
+			conditions = append(conditions, "use item")
+		}
+	case "trade":
+		conditions = append(conditions, "trade")
+	default:
+		if d.Trigger.Name != "" {
+			conditions = append(conditions, d.Trigger.Name)
+		}
+	}
+
+	if d.Trigger.Name != "use-item" && d.Item != nil {
+		conditions = append(conditions, fmt.Sprintf("with %s", d.Item.Name))
+	}
+	if d.HeldItem != nil {
+		conditions = append(conditions, fmt.Sprintf("holding %s", d.HeldItem.Name))
+	}
+	if d.TradeSpecies != nil {
+		conditions = append(conditions, fmt.Sprintf("for %s", d.TradeSpecies.Name))
+	}
+	if d.MinHappiness != nil {
+		conditions = append(conditions, fmt.Sprintf("happiness %d+", *d.MinHappiness))
+	}
+	if d.MinAffection != nil {
+		conditions = append(conditions, fmt.Sprintf("affection %d+", *d.MinAffection))
+	}
+	if d.MinBeauty != nil {
+		conditions = append(conditions, fmt.Sprintf("beauty %d+", *d.MinBeauty))
+	}
+	if d.KnownMove != nil {
+		conditions = append(conditions, fmt.Sprintf("knowing %s", d.KnownMove.Name))
+	}
+	if d.KnownMoveType != nil {
+		conditions = append(conditions, fmt.Sprintf("knowing a %s move", d.KnownMoveType.Name))
+	}
+	if d.Location != nil {
+		conditions = append(conditions, fmt.Sprintf("at %s", d.Location.Name))
+	}
+	if d.TimeOfDay != "" {
+		conditions = append(conditions, fmt.Sprintf("during the %s", d.TimeOfDay))
+	}
+	if d.Gender != nil {
+		gender := "male"
+		if *d.Gender == 1 {
+			gender = "female"
+		}
+		conditions = append(conditions, fmt.Sprintf("if %s", gender))
+	}
+	if d.PartySpecies != nil {
+		conditions = append(conditions, fmt.Sprintf("with %s in party", d.PartySpecies.Name))
+	}
+	if d.PartyType != nil {
+		conditions = append(conditions, fmt.Sprintf("with a %s type in party", d.PartyType.Name))
+	}
+	if d.RelativePhysicalStats != nil {
+		switch *d.RelativePhysicalStats {
+		case 1:
+			conditions = append(conditions, "attack > defense")
+		case -1:
+			conditions = append(conditions, "attack < defense")
+		case 0:
+			conditions = append(conditions, "attack = defense")
+		}
+	}
+	if d.NeedsOverworldRain {
+		conditions = append(conditions, "in the rain")
+	}
+	if d.TurnUpsideDown {
+		conditions = append(conditions, "holding the console upside down")
+	}
+
+	return strings.Join(conditions, ", ")
+}
+
+type EvolutionChain struct {
+	ID              int                     `json:"id"`
+	BabyTriggerItem *NamedAPIResource[Item] `json:"baby_trigger_item"`
+	Chain           ChainLink               `json:"chain"`
+}
+
+type ChainLink struct {
+	IsBaby           bool                             `json:"is_baby"`
+	Species          NamedAPIResource[PokemonSpecies] `json:"species"`
+	EvolutionDetails []EvolutionDetail                `json:"evolution_details"`
+	EvolvesTo        []ChainLink                      `json:"evolves_to"`
+}
+
+type EvolutionDetail struct {
+	Trigger               NamedAPIResource[any]             `json:"trigger"`
+	Item                  *NamedAPIResource[Item]           `json:"item"`
+	HeldItem              *NamedAPIResource[Item]           `json:"held_item"`
+	KnownMove             *NamedAPIResource[Move]           `json:"known_move"`
+	KnownMoveType         *NamedAPIResource[Type]           `json:"known_move_type"`
+	Location              *NamedAPIResource[Location]       `json:"location"`
+	PartySpecies          *NamedAPIResource[PokemonSpecies] `json:"party_species"`
+	PartyType             *NamedAPIResource[Type]           `json:"party_type"`
+	TradeSpecies          *NamedAPIResource[PokemonSpecies] `json:"trade_species"`
+	Gender                *int                              `json:"gender"`
+	MinLevel              *int                              `json:"min_level"`
+	MinHappiness          *int                              `json:"min_happiness"`
+	MinBeauty             *int                              `json:"min_beauty"`
+	MinAffection          *int                              `json:"min_affection"`
+	RelativePhysicalStats *int                              `json:"relative_physical_stats"`
+	TimeOfDay             string                            `json:"time_of_day"`
+	NeedsOverworldRain    bool                              `json:"needs_overworld_rain"`
+	TurnUpsideDown        bool                              `json:"turn_upside_down"`
+}
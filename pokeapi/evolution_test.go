@@ -0,0 +1,106 @@
+package pokeapi
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+const wurmpleChain = `{
+	"id": 135,
+	"chain": {
+		"species": {"name": "wurmple"},
+		"evolution_details": [],
+		"evolves_to": [
+			{
+				"species": {"name": "silcoon"},
+				"evolution_details": [{"trigger": {"name": "level-up"}, "min_level": 7}],
+				"evolves_to": [
+					{
+						"species": {"name": "beautifly"},
+						"evolution_details": [{"trigger": {"name": "level-up"}, "min_level": 10}],
+						"evolves_to": []
+					}
+				]
+			},
+			{
+				"species": {"name": "cascoon"},
+				"evolution_details": [{"trigger": {"name": "level-up"}, "min_level": 7}],
+				"evolves_to": [
+					{
+						"species": {"name": "dustox"},
+						"evolution_details": [{"trigger": {"name": "level-up"}, "min_level": 10, "time_of_day": "night"}],
+						"evolves_to": []
+					}
+				]
+			}
+		]
+	}
+}`
+
+func TestEvolutionChainTraversal(t *testing.T) {
+	chain := EvolutionChain{}
+	if err := json.Unmarshal([]byte(wurmpleChain), &chain); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := chain.Predecessors("dustox"); !reflect.DeepEqual(got, []string{"wurmple", "cascoon"}) {
+		t.Errorf("unexpected predecessors of dustox: %v", got)
+	}
+	if got := chain.Predecessors("wurmple"); len(got) != 0 {
+		t.Errorf("expected no predecessors of wurmple, got %v", got)
+	}
+
+	successors := chain.Successors("wurmple")
+	if len(successors) != 2 || successors[0].Species.Name != "silcoon" || successors[1].Species.Name != "cascoon" {
+		t.Errorf("unexpected successors of wurmple: %+v", successors)
+	}
+	if got := chain.Successors("beautifly"); len(got) != 0 {
+		t.Errorf("expected no successors of beautifly, got %+v", got)
+	}
+
+	if _, ok := chain.Find("pikachu"); ok {
+		t.Errorf("expected pikachu to not be in the chain")
+	}
+
+	dustox, ok := chain.Find("dustox")
+	if !ok {
+		t.Fatalf("expected dustox to be in the chain")
+	}
+	if got := dustox.EvolutionDetails[0].String(); got != "level 10, during the night" {
+		t.Errorf("unexpected evolution detail %q", got)
+	}
+}
+
+func TestEvolutionDetailString(t *testing.T) {
+	level := 16
+	happiness := 160
+
+	cases := []struct {
+		detail EvolutionDetail
+		want   string
+	}{
+		{
+			detail: EvolutionDetail{Trigger: NamedAPIResource[any]{Name: "level-up"}, MinLevel: &level},
+			want:   "level 16",
+		},
+		{
+			detail: EvolutionDetail{Trigger: NamedAPIResource[any]{Name: "use-item"}, Item: &NamedAPIResource[Item]{Name: "water-stone"}},
+			want:   "use water-stone",
+		},
+		{
+			detail: EvolutionDetail{Trigger: NamedAPIResource[any]{Name: "trade"}, HeldItem: &NamedAPIResource[Item]{Name: "metal-coat"}},
+			want:   "trade, holding metal-coat",
+		},
+		{
+			detail: EvolutionDetail{Trigger: NamedAPIResource[any]{Name: "level-up"}, MinHappiness: &happiness, TimeOfDay: "day"},
+			want:   "level up, happiness 160+, during the day",
+		},
+	}
+
+	for _, c := range cases {
+		if got := c.detail.String(); got != c.want {
+			t.Errorf("expected %q, got %q", c.want, got)
+		}
+	}
+}
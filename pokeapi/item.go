@@ -0,0 +1,57 @@
+package pokeapi
+
+import (
+	"context"
+	"strings"
+)
+
+const EndpointItem = "item"
+
+func (c *Client) GetItem(name string) (Item, error) {
+	return c.GetItemContext(context.Background(), name)
+}
+
+func (c *Client) GetItemContext(ctx context.Context, name string) (Item, error) {
+	return Get[Item](ctx, c, EndpointItem, name)
+}
+
+func (i Item) ShortEffect(language string) string {
+	return strings.Join(strings.Fields(findEffect(i.EffectEntries, language, false)), " ")
+}
+
+func (i Item) Effect(language string) string {
+	return strings.Join(strings.Fields(findEffect(i.EffectEntries, language, true)), " ")
+}
+
+func (i Item) IsPokeBall() bool {
+	switch i.Category.Name {
+	case "standard-balls", "special-balls", "apricorn-balls":
+		return true
+	}
+
+	return false
+}
+
+type Item struct {
+	ID                int                     `json:"id"`
+	Name              string                  `json:"name"`
+	Cost              int                     `json:"cost"`
+	FlingPower        *int                    `json:"fling_power"`
+	FlingEffect       *NamedAPIResource[any]  `json:"fling_effect"`
+	Attributes        []NamedAPIResource[any] `json:"attributes"`
+	Category          NamedAPIResource[any]   `json:"category"`
+	EffectEntries     []VerboseEffect         `json:"effect_entries"`
+	FlavorTextEntries []struct {
+		Text         string                `json:"text"`
+		Language     NamedAPIResource[any] `json:"language"`
+		VersionGroup NamedAPIResource[any] `json:"version_group"`
+	} `json:"flavor_text_entries"`
+	Names         []Name `json:"names"`
+	HeldByPokemon []struct {
+		Pokemon        NamedAPIResource[PokemonData] `json:"pokemon"`
+		VersionDetails []struct {
+			Rarity  int                   `json:"rarity"`
+			Version NamedAPIResource[any] `json:"version"`
+		} `json:"version_details"`
+	} `json:"held_by_pokemon"`
+}
@@ -0,0 +1,39 @@
+package pokeapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetItem(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{
+			"name": "ultra-ball",
+			"cost": 800,
+			"category": {"name": "standard-balls"},
+			"effect_entries": [{"effect": "Used in battle\n:   Attempts to catch a wild Pokémon, using a catch rate of 2×.", "short_effect": "Tries to catch a wild Pokémon.", "language": {"name": "en"}}]
+		}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(WithBaseURL(server.URL))
+
+	item, err := client.GetItem("ultra-ball")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !item.IsPokeBall() || item.Cost != 800 {
+		t.Errorf("unexpected item: %+v", item)
+	}
+	if got := item.ShortEffect("en"); got != "Tries to catch a wild Pokémon." {
+		t.Errorf("unexpected short effect %q", got)
+	}
+	if got := item.Effect("en"); got != "Used in battle : Attempts to catch a wild Pokémon, using a catch rate of 2×." {
+		t.Errorf("unexpected effect %q", got)
+	}
+	if (Item{Category: NamedAPIResource[any]{Name: "healing"}}).IsPokeBall() {
+		t.Errorf("expected a healing item to not be a Poké Ball")
+	}
+}
@@ -0,0 +1,93 @@
+package pokeapi
+
+import (
+	"context"
+	"sort"
+)
+
+func (c *Client) GetLearnset(pokemon string, versionGroup string) ([]LearnsetEntry, error) {
+	return c.GetLearnsetContext(context.Background(), pokemon, versionGroup)
+}
+
+// Empty versionGroup picks the most recent one the Pokemon has moves in
+func (c *Client) GetLearnsetContext(ctx context.Context, pokemon string, versionGroup string) ([]LearnsetEntry, error) {
+	pokemonData, err := Get[PokemonData](ctx, c, EndpointPokemon, pokemon)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if versionGroup == "" {
+		versionGroup = pokemonData.LatestVersionGroup()
+	}
+
+	return pokemonData.Learnset(versionGroup), nil
+}
+
+// Moves learned in the version group, ordered by method, then level, then name
+func (p PokemonData) Learnset(versionGroup string) []LearnsetEntry {
+	learnset := make([]LearnsetEntry, 0)
+
+	for _, move := range p.Moves {
+		for _, details := range move.VersionGroupDetails {
+			if details.VersionGroup.Name != versionGroup {
+				continue
+			}
+
+			learnset = append(learnset, LearnsetEntry{
+				Move:         move.Move.Name,
+				Method:       details.MoveLearnMethod.Name,
+				Level:        details.LevelLearnedAt,
+				VersionGroup: details.VersionGroup.Name,
+			})
+		}
+	}
+
+	sort.SliceStable(learnset, func(i, j int) bool {
+		if learnset[i].Method != learnset[j].Method {
+			return learnMethodOrder(learnset[i].Method) < learnMethodOrder(learnset[j].Method)
+		}
+		if learnset[i].Level != learnset[j].Level {
+			return learnset[i].Level < learnset[j].Level
+		}
+		return learnset[i].Move < learnset[j].Move
+	})
+
+	return learnset
+}
+
+func (p PokemonData) LatestVersionGroup() string {
+	latest := NamedAPIResource[any]{}
+
+	for _, move := range p.Moves {
+		for _, details := range move.VersionGroupDetails {
+			if details.VersionGroup.ID() > latest.ID() {
+				latest = details.VersionGroup
+			}
+		}
+	}
+
+	return latest.Name
+}
+
+func learnMethodOrder(method string) string {
+	switch method {
+	case "level-up":
+		return "0"
+	case "machine":
+		return "1"
+	case "egg":
+		return "2"
+	case "tutor":
+		return "3"
+	}
+
+	return "4" + method
+}
+
+type LearnsetEntry struct {
+	Move         string
+	Method       string
+	Level        int
+	VersionGroup string
+}
@@ -0,0 +1,64 @@
+package pokeapi
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+const pikachuMoves = `{
+	"name": "pikachu",
+	"moves": [
+		{
+			"move": {"name": "thunderbolt"},
+			"version_group_details": [
+				{"level_learned_at": 0, "version_group": {"name": "red-blue", "url": "https://pokeapi.co/api/v2/version-group/1/"}, "move_learn_method": {"name": "machine"}},
+				{"level_learned_at": 0, "version_group": {"name": "sword-shield", "url": "https://pokeapi.co/api/v2/version-group/20/"}, "move_learn_method": {"name": "machine"}}
+			]
+		},
+		{
+			"move": {"name": "thunder-shock"},
+			"version_group_details": [
+				{"level_learned_at": 1, "version_group": {"name": "red-blue", "url": "https://pokeapi.co/api/v2/version-group/1/"}, "move_learn_method": {"name": "level-up"}}
+			]
+		},
+		{
+			"move": {"name": "thunder"},
+			"version_group_details": [
+				{"level_learned_at": 43, "version_group": {"name": "red-blue", "url": "https://pokeapi.co/api/v2/version-group/1/"}, "move_learn_method": {"name": "level-up"}}
+			]
+		},
+		{
+			"move": {"name": "growl"},
+			"version_group_details": [
+				{"level_learned_at": 1, "version_group": {"name": "red-blue", "url": "https://pokeapi.co/api/v2/version-group/1/"}, "move_learn_method": {"name": "level-up"}}
+			]
+		}
+	]
+}`
+
+func TestLearnset(t *testing.T) {
+	pokemon := PokemonData{}
+	if err := json.Unmarshal([]byte(pikachuMoves), &pokemon); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []LearnsetEntry{
+		{Move: "growl", Method: "level-up", Level: 1, VersionGroup: "red-blue"},
+		{Move: "thunder-shock", Method: "level-up", Level: 1, VersionGroup: "red-blue"},
+		{Move: "thunder", Method: "level-up", Level: 43, VersionGroup: "red-blue"},
+		{Move: "thunderbolt", Method: "machine", Level: 0, VersionGroup: "red-blue"},
+	}
+
+	if got := pokemon.Learnset("red-blue"); !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected red-blue learnset:\n got %+v\nwant %+v", got, want)
+	}
+
+	if got := pokemon.LatestVersionGroup(); got != "sword-shield" {
+		t.Errorf("expected latest version group sword-shield, got %s", got)
+	}
+
+	if got := pokemon.Learnset("gold-silver"); len(got) != 0 {
+		t.Errorf("expected empty gold-silver learnset, got %+v", got)
+	}
+}
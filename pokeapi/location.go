@@ -0,0 +1,46 @@
+package pokeapi
+
+import "context"
+
+const (
+	EndpointRegion   = "region"
+	EndpointLocation = "location"
+)
+
+func (c *Client) GetRegion(name string) (Region, error) {
+	return c.GetRegionContext(context.Background(), name)
+}
+
+func (c *Client) GetRegionContext(ctx context.Context, name string) (Region, error) {
+	return Get[Region](ctx, c, EndpointRegion, name)
+}
+
+func (c *Client) GetLocation(name string) (Location, error) {
+	return c.GetLocationContext(context.Background(), name)
+}
+
+func (c *Client) GetLocationContext(ctx context.Context, name string) (Location, error) {
+	return Get[Location](ctx, c, EndpointLocation, name)
+}
+
+type Region struct {
+	ID             int                          `json:"id"`
+	Name           string                       `json:"name"`
+	Locations      []NamedAPIResource[Location] `json:"locations"`
+	MainGeneration *NamedAPIResource[any]       `json:"main_generation"`
+	Names          []Name                       `json:"names"`
+	Pokedexes      []NamedAPIResource[any]      `json:"pokedexes"`
+	VersionGroups  []NamedAPIResource[any]      `json:"version_groups"`
+}
+
+type Location struct {
+	ID          int                              `json:"id"`
+	Name        string                           `json:"name"`
+	Region      *NamedAPIResource[Region]        `json:"region"`
+	Areas       []NamedAPIResource[LocationArea] `json:"areas"`
+	Names       []Name                           `json:"names"`
+	GameIndices []struct {
+		GameIndex  int                   `json:"game_index"`
+		Generation NamedAPIResource[any] `json:"generation"`
+	} `json:"game_indices"`
+}
@@ -0,0 +1,44 @@
+package pokeapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRegionHierarchy(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/region/kanto/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"id":1,"name":"kanto","locations":[{"name":"viridian-forest","url":"%s/location/321/"}]}`, server.URL)
+	})
+	mux.HandleFunc("/location/321/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"id":321,"name":"viridian-forest","region":{"name":"kanto"},"areas":[{"name":"viridian-forest-area","url":"%s/location-area/321/"}]}`, server.URL)
+	})
+
+	client := NewClient(WithBaseURL(server.URL))
+	ctx := context.Background()
+
+	region, err := client.GetRegion("kanto")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(region.Locations) != 1 || region.Locations[0].ID() != 321 {
+		t.Fatalf("unexpected region: %+v", region)
+	}
+
+	location, err := region.Locations[0].Resolve(ctx, client)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if location.Region == nil || location.Region.Name != "kanto" {
+		t.Errorf("expected location in kanto, got %+v", location.Region)
+	}
+	if len(location.Areas) != 1 || location.Areas[0].Name != "viridian-forest-area" {
+		t.Errorf("unexpected areas: %+v", location.Areas)
+	}
+}
@@ -0,0 +1,74 @@
+package pokeapi
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+const EndpointMove = "move"
+
+func (c *Client) GetMove(name string) (Move, error) {
+	return c.GetMoveContext(context.Background(), name)
+}
+
+func (c *Client) GetMoveContext(ctx context.Context, name string) (Move, error) {
+	return Get[Move](ctx, c, EndpointMove, name)
+}
+
+// Short effect text in the given language with the effect chance filled in
+func (m Move) Effect(language string) string {
+	effect := findEffect(m.EffectEntries, language, false)
+
+	if m.EffectChance != nil {
+		effect = strings.ReplaceAll(effect, "$effect_chance", fmt.Sprint(*m.EffectChance))
+	}
+
+	return strings.Join(strings.Fields(effect), " ")
+}
+
+type Move struct {
+	ID                int                    `json:"id"`
+	Name              string                 `json:"name"`
+	Accuracy          *int                   `json:"accuracy"`
+	EffectChance      *int                   `json:"effect_chance"`
+	PP                *int                   `json:"pp"`
+	Priority          int                    `json:"priority"`
+	Power             *int                   `json:"power"`
+	DamageClass       NamedAPIResource[any]  `json:"damage_class"`
+	Type              NamedAPIResource[Type] `json:"type"`
+	Target            NamedAPIResource[any]  `json:"target"`
+	Generation        NamedAPIResource[any]  `json:"generation"`
+	EffectEntries     []VerboseEffect        `json:"effect_entries"`
+	FlavorTextEntries []struct {
+		FlavorText   string                `json:"flavor_text"`
+		Language     NamedAPIResource[any] `json:"language"`
+		VersionGroup NamedAPIResource[any] `json:"version_group"`
+	} `json:"flavor_text_entries"`
+	Names []Name `json:"names"`
+}
+
+// The last entry in the language wins, as Move.Effect has always done
+func findEffect(entries []VerboseEffect, language string, long bool) string {
+	effect := ""
+
+	for _, entry := range entries {
+		if entry.Language.Name != language {
+			continue
+		}
+
+		if long {
+			effect = entry.Effect
+		} else {
+			effect = entry.ShortEffect
+		}
+	}
+
+	return effect
+}
+
+type VerboseEffect struct {
+	Effect      string                `json:"effect"`
+	ShortEffect string                `json:"short_effect"`
+	Language    NamedAPIResource[any] `json:"language"`
+}
@@ -0,0 +1,88 @@
+package pokeapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestGetMove(t *testing.T) {
+	var gotPaths []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPaths = append(gotPaths, r.URL.Path)
+
+		switch r.URL.Path {
+		case "/move/thunderbolt/":
+			w.Write([]byte(`{
+				"name": "thunderbolt",
+				"power": 90,
+				"accuracy": 100,
+				"pp": 15,
+				"effect_chance": 10,
+				"damage_class": {"name": "special"},
+				"type": {"name": "electric", "url": "https://pokeapi.co/api/v2/type/13/"},
+				"effect_entries": [{"short_effect": "Has a $effect_chance% chance to paralyze the target.", "language": {"name": "en"}}]
+			}`))
+		case "/move/shadow-half/":
+			w.Write([]byte(`{
+				"name": "shadow-half",
+				"power": null,
+				"accuracy": null,
+				"pp": null,
+				"effect_chance": null,
+				"damage_class": {"name": "special"},
+				"type": {"name": "shadow", "url": "https://pokeapi.co/api/v2/type/10002/"}
+			}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(WithBaseURL(server.URL))
+
+	thunderbolt, err := client.GetMove("thunderbolt")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if thunderbolt.Power == nil || *thunderbolt.Power != 90 || thunderbolt.Accuracy == nil || *thunderbolt.Accuracy != 100 || thunderbolt.PP == nil || *thunderbolt.PP != 15 {
+		t.Errorf("unexpected power, accuracy or pp: %+v", thunderbolt)
+	}
+	if thunderbolt.Type.Name != "electric" || thunderbolt.DamageClass.Name != "special" {
+		t.Errorf("unexpected type or damage class: %+v", thunderbolt)
+	}
+	if got := thunderbolt.Effect("en"); got != "Has a 10% chance to paralyze the target." {
+		t.Errorf("unexpected effect %q", got)
+	}
+
+	shadowHalf, err := client.GetMove("shadow-half")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if shadowHalf.Power != nil || shadowHalf.Accuracy != nil || shadowHalf.PP != nil || shadowHalf.EffectChance != nil {
+		t.Errorf("expected null power, accuracy, pp and effect chance to decode as nil, got %+v", shadowHalf)
+	}
+
+	want := []string{"/move/thunderbolt/", "/move/shadow-half/"}
+	if !reflect.DeepEqual(gotPaths, want) {
+		t.Errorf("expected requests to %v, got %v", want, gotPaths)
+	}
+}
+
+func TestMoveEffect(t *testing.T) {
+	chance := 10
+	move := Move{
+		EffectChance: &chance,
+		EffectEntries: []VerboseEffect{
+			{ShortEffect: "Has a $effect_chance% chance to\nparalyze the target.", Language: NamedAPIResource[any]{Name: "en"}},
+		},
+	}
+
+	if got := move.Effect("en"); got != "Has a 10% chance to paralyze the target." {
+		t.Errorf("unexpected effect %q", got)
+	}
+}
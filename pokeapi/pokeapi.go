@@ -1,132 +1,133 @@
 package pokeapi
 
 import (
-	"encoding/json"
-	"fmt"
-	"io"
-	"log"
-	"net/http"
+	"context"
 )
 
-func GetLocationAreaNames(limit int, offset int) ([]string, error) {
-	names := make([]string, offset+limit+1)
-	for i := offset; i <= offset+limit; i++ {
-		newName, err := getLocationAreaName(i)
-
-		if err != nil {
-			return names[offset : offset+limit], err
-		}
-
-		names[i] = newName
-	}
+func (c *Client) GetLocationAreaNames(limit int, offset int) (LocationAreaPage, error) {
+	return c.GetLocationAreaNamesContext(context.Background(), limit, offset)
+}
 
-	return names[offset : offset+limit], nil
+func (c *Client) GetLocationAreaNamesContext(ctx context.Context, limit int, offset int) (LocationAreaPage, error) {
+	return c.GetLocationAreaPageContext(ctx, c.LocationAreaPageURL(limit, offset))
 }
 
-func GetPokemonsInArea(name string) ([]string, error) {
-	pokemonNames := make([]string, 0)
+func (c *Client) GetLocationAreaPage(pageURL string) (LocationAreaPage, error) {
+	return c.GetLocationAreaPageContext(context.Background(), pageURL)
+}
 
-	locationArea, err := getLocationArea(name)
+func (c *Client) GetLocationAreaPageContext(ctx context.Context, pageURL string) (LocationAreaPage, error) {
+	page := LocationAreaPage{}
+	resourceList, err := GetByURL[NamedAPIResourceList[LocationArea]](ctx, c, pageURL)
 
 	if err != nil {
-		return pokemonNames, err
-	}
-
-	for _, pokemonEncounter := range locationArea.PokemonEncounters {
-		pokemonNames = append(pokemonNames, pokemonEncounter.Pokemon.Name)
+		return page, err
 	}
 
-	return pokemonNames, nil
-}
-
-func GetPokemonToCatch(name string) (PokemonToCatch, error) {
-	pokemonToCatch := PokemonToCatch{}
-
-	pokemonData, err := getPokemonData(name)
+	page.Count = resourceList.Count
 
-	if err != nil {
-		return pokemonToCatch, nil
+	if resourceList.Next != nil {
+		page.Next = *resourceList.Next
 	}
 
-	pokemonToCatch.Name = pokemonData.Name
-	pokemonToCatch.BaseExperience = pokemonData.BaseExperience
-	pokemonToCatch.Height = pokemonData.Height
-	pokemonToCatch.Weight = pokemonData.Weight
+	if resourceList.Previous != nil {
+		page.Previous = *resourceList.Previous
+	}
 
-	pokemonToCatch.Stats = make(map[string]int)
+	page.Names = make([]string, 0, len(resourceList.Results))
 
-	for _, stat := range pokemonData.Stats {
-		pokemonToCatch.Stats[stat.Stat.Name] = stat.BaseStat
+	for _, result := range resourceList.Results {
+		page.Names = append(page.Names, result.Name)
 	}
 
-	pokemonToCatch.Types = make([]string, 0)
+	return page, nil
+}
 
-	for _, pType := range pokemonData.Types {
-		pokemonToCatch.Types = append(pokemonToCatch.Types, pType.Type.Name)
-	}
+func (c *Client) LocationAreaPageURL(limit int, offset int) string {
+	return c.ListURL(EndpointLocationArea, limit, offset)
+}
 
-	return pokemonToCatch, nil
+func (c *Client) GetPokemonToCatch(name string) (PokemonToCatch, error) {
+	return c.GetPokemonToCatchContext(context.Background(), name)
 }
 
-func getLocationAreaName(page int) (string, error) {
-	locationArea, err := getLocationArea(fmt.Sprint(page))
+func (c *Client) GetPokemonToCatchContext(ctx context.Context, name string) (PokemonToCatch, error) {
+	pokemonData, err := Get[PokemonData](ctx, c, EndpointPokemon, name)
 
 	if err != nil {
-		return "", nil
+		return PokemonToCatch{}, err
 	}
 
-	return locationArea.Name, nil
+	return newPokemonToCatch(pokemonData), nil
 }
 
-func getPokemonData(name string) (PokemonData, error) {
-	pokemonData := PokemonData{}
+// Same as GetPokemonToCatch plus a second request for the species, which
+// fills in the genus, flavor text and capture rate
+func (c *Client) GetPokemonToCatchWithSpecies(name string) (PokemonToCatch, error) {
+	return c.GetPokemonToCatchWithSpeciesContext(context.Background(), name)
+}
+
+func (c *Client) GetPokemonToCatchWithSpeciesContext(ctx context.Context, name string) (PokemonToCatch, error) {
+	pokemonData, err := Get[PokemonData](ctx, c, EndpointPokemon, name)
 
-	res, err := http.Get(fmt.Sprintf("https://pokeapi.co/api/v2/pokemon/%s/", name))
 	if err != nil {
-		log.Fatal(err)
+		return PokemonToCatch{}, err
 	}
-	body, err := io.ReadAll(res.Body)
-	res.Body.Close()
-	if res.StatusCode > 299 {
-		return pokemonData, fmt.Errorf("Response failed with status code: %d and\nbody: %s\n", res.StatusCode, body)
-	}
-	if err != nil {
-		return pokemonData, err
+
+	pokemonToCatch := newPokemonToCatch(pokemonData)
+
+	if pokemonData.Species.URL == "" {
+		return pokemonToCatch, nil
 	}
 
-	jsonErr := json.Unmarshal(body, &pokemonData)
+	species, err := pokemonData.Species.Resolve(ctx, c)
 
-	if jsonErr != nil {
-		return pokemonData, jsonErr
+	if err != nil {
+		return pokemonToCatch, err
 	}
 
-	return pokemonData, nil
+	pokemonToCatch.Genus = species.Genus("en")
+	pokemonToCatch.FlavorText = species.FlavorText("en")
+	pokemonToCatch.CaptureRate = species.CaptureRate
+	pokemonToCatch.IsLegendary = species.IsLegendary
+	pokemonToCatch.IsMythical = species.IsMythical
 
+	return pokemonToCatch, nil
 }
 
-func getLocationArea(idOrName string) (LocationArea, error) {
-	locationArea := LocationArea{}
+func newPokemonToCatch(pokemonData PokemonData) PokemonToCatch {
+	pokemonToCatch := PokemonToCatch{}
 
-	res, err := http.Get(fmt.Sprintf("https://pokeapi.co/api/v2/location-area/%s/", idOrName))
-	if err != nil {
-		log.Fatal(err)
-	}
-	body, err := io.ReadAll(res.Body)
-	res.Body.Close()
-	if res.StatusCode > 299 {
-		return locationArea, fmt.Errorf("Response failed with status code: %d and\nbody: %s\n", res.StatusCode, body)
+	pokemonToCatch.Name = pokemonData.Name
+	pokemonToCatch.BaseExperience = pokemonData.BaseExperience
+	pokemonToCatch.Height = pokemonData.Height
+	pokemonToCatch.Weight = pokemonData.Weight
+
+	pokemonToCatch.Stats = make(map[string]int)
+
+	for _, stat := range pokemonData.Stats {
+		pokemonToCatch.Stats[stat.Stat.Name] = stat.BaseStat
 	}
-	if err != nil {
-		return locationArea, err
+
+	pokemonToCatch.Types = make([]string, 0)
+
+	for _, pType := range pokemonData.Types {
+		pokemonToCatch.Types = append(pokemonToCatch.Types, pType.Type.Name)
 	}
 
-	jsonErr := json.Unmarshal(body, &locationArea)
+	pokemonToCatch.Abilities = make([]PokemonAbility, 0)
 
-	if jsonErr != nil {
-		return locationArea, jsonErr
+	for _, ability := range pokemonData.Abilities {
+		pokemonToCatch.Abilities = append(pokemonToCatch.Abilities, PokemonAbility{
+			Name:     ability.Ability.Name,
+			IsHidden: ability.IsHidden,
+			Slot:     ability.Slot,
+		})
 	}
 
-	return locationArea, nil
+	pokemonToCatch.Species = pokemonData.Species.Name
+
+	return pokemonToCatch
 }
 
 type PokemonToCatch struct {
@@ -136,6 +137,26 @@ type PokemonToCatch struct {
 	Height         int
 	Stats          map[string]int
 	Types          []string
+	Abilities      []PokemonAbility
+	Species        string
+	Genus          string
+	FlavorText     string
+	CaptureRate    int
+	IsLegendary    bool
+	IsMythical     bool
+}
+
+type PokemonAbility struct {
+	Name     string
+	IsHidden bool
+	Slot     int
+}
+
+type LocationAreaPage struct {
+	Count    int
+	Next     string
+	Previous string
+	Names    []string
 }
 
 type LocationArea struct {
@@ -143,51 +164,17 @@ type LocationArea struct {
 	Name                 string `json:"name"`
 	GameIndex            int    `json:"game_index"`
 	EncounterMethodRates []struct {
-		EncounterMethod struct {
-			Name string `json:"name"`
-			URL  string `json:"url"`
-		} `json:"encounter_method"`
-		VersionDetails []struct {
-			Rate    int `json:"rate"`
-			Version struct {
-				Name string `json:"name"`
-				URL  string `json:"url"`
-			} `json:"version"`
+		EncounterMethod NamedAPIResource[any] `json:"encounter_method"`
+		VersionDetails  []struct {
+			Rate    int                   `json:"rate"`
+			Version NamedAPIResource[any] `json:"version"`
 		} `json:"version_details"`
 	} `json:"encounter_method_rates"`
-	Location struct {
-		Name string `json:"name"`
-		URL  string `json:"url"`
-	} `json:"location"`
-	Names []struct {
-		Name     string `json:"name"`
-		Language struct {
-			Name string `json:"name"`
-			URL  string `json:"url"`
-		} `json:"language"`
-	} `json:"names"`
+	Location          NamedAPIResource[Location] `json:"location"`
+	Names             []Name                     `json:"names"`
 	PokemonEncounters []struct {
-		Pokemon struct {
-			Name string `json:"name"`
-			URL  string `json:"url"`
-		} `json:"pokemon"`
-		VersionDetails []struct {
-			Version struct {
-				Name string `json:"name"`
-				URL  string `json:"url"`
-			} `json:"version"`
-			MaxChance        int `json:"max_chance"`
-			EncounterDetails []struct {
-				MinLevel        int   `json:"min_level"`
-				MaxLevel        int   `json:"max_level"`
-				ConditionValues []any `json:"condition_values"`
-				Chance          int   `json:"chance"`
-				Method          struct {
-					Name string `json:"name"`
-					URL  string `json:"url"`
-				} `json:"method"`
-			} `json:"encounter_details"`
-		} `json:"version_details"`
+		Pokemon        NamedAPIResource[PokemonData] `json:"pokemon"`
+		VersionDetails []VersionEncounterDetail      `json:"version_details"`
 	} `json:"pokemon_encounters"`
 }
 
@@ -200,59 +187,32 @@ type PokemonData struct {
 	Order          int    `json:"order"`
 	Weight         int    `json:"weight"`
 	Abilities      []struct {
-		IsHidden bool `json:"is_hidden"`
-		Slot     int  `json:"slot"`
-		Ability  struct {
-			Name string `json:"name"`
-			URL  string `json:"url"`
-		} `json:"ability"`
+		IsHidden bool                      `json:"is_hidden"`
+		Slot     int                       `json:"slot"`
+		Ability  NamedAPIResource[Ability] `json:"ability"`
 	} `json:"abilities"`
-	Forms []struct {
-		Name string `json:"name"`
-		URL  string `json:"url"`
-	} `json:"forms"`
+	Forms       []NamedAPIResource[any] `json:"forms"`
 	GameIndices []struct {
-		GameIndex int `json:"game_index"`
-		Version   struct {
-			Name string `json:"name"`
-			URL  string `json:"url"`
-		} `json:"version"`
+		GameIndex int                   `json:"game_index"`
+		Version   NamedAPIResource[any] `json:"version"`
 	} `json:"game_indices"`
 	HeldItems []struct {
-		Item struct {
-			Name string `json:"name"`
-			URL  string `json:"url"`
-		} `json:"item"`
+		Item           NamedAPIResource[Item] `json:"item"`
 		VersionDetails []struct {
-			Rarity  int `json:"rarity"`
-			Version struct {
-				Name string `json:"name"`
-				URL  string `json:"url"`
-			} `json:"version"`
+			Rarity  int                   `json:"rarity"`
+			Version NamedAPIResource[any] `json:"version"`
 		} `json:"version_details"`
 	} `json:"held_items"`
 	LocationAreaEncounters string `json:"location_area_encounters"`
 	Moves                  []struct {
-		Move struct {
-			Name string `json:"name"`
-			URL  string `json:"url"`
-		} `json:"move"`
+		Move                NamedAPIResource[Move] `json:"move"`
 		VersionGroupDetails []struct {
-			LevelLearnedAt int `json:"level_learned_at"`
-			VersionGroup   struct {
-				Name string `json:"name"`
-				URL  string `json:"url"`
-			} `json:"version_group"`
-			MoveLearnMethod struct {
-				Name string `json:"name"`
-				URL  string `json:"url"`
-			} `json:"move_learn_method"`
+			LevelLearnedAt  int                   `json:"level_learned_at"`
+			VersionGroup    NamedAPIResource[any] `json:"version_group"`
+			MoveLearnMethod NamedAPIResource[any] `json:"move_learn_method"`
 		} `json:"version_group_details"`
 	} `json:"moves"`
-	Species struct {
-		Name string `json:"name"`
-		URL  string `json:"url"`
-	} `json:"species"`
+	Species NamedAPIResource[PokemonSpecies] `json:"species"`
 	Sprites struct {
 		BackDefault      string `json:"back_default"`
 		BackFemale       any    `json:"back_female"`
@@ -434,31 +394,19 @@ type PokemonData struct {
 		Legacy string `json:"legacy"`
 	} `json:"cries"`
 	Stats []struct {
-		BaseStat int `json:"base_stat"`
-		Effort   int `json:"effort"`
-		Stat     struct {
-			Name string `json:"name"`
-			URL  string `json:"url"`
-		} `json:"stat"`
+		BaseStat int                   `json:"base_stat"`
+		Effort   int                   `json:"effort"`
+		Stat     NamedAPIResource[any] `json:"stat"`
 	} `json:"stats"`
 	Types []struct {
-		Slot int `json:"slot"`
-		Type struct {
-			Name string `json:"name"`
-			URL  string `json:"url"`
-		} `json:"type"`
+		Slot int                    `json:"slot"`
+		Type NamedAPIResource[Type] `json:"type"`
 	} `json:"types"`
 	PastTypes []struct {
-		Generation struct {
-			Name string `json:"name"`
-			URL  string `json:"url"`
-		} `json:"generation"`
-		Types []struct {
-			Slot int `json:"slot"`
-			Type struct {
-				Name string `json:"name"`
-				URL  string `json:"url"`
-			} `json:"type"`
+		Generation NamedAPIResource[any] `json:"generation"`
+		Types      []struct {
+			Slot int                    `json:"slot"`
+			Type NamedAPIResource[Type] `json:"type"`
 		} `json:"types"`
 	} `json:"past_types"`
 }
@@ -0,0 +1,102 @@
+package pokeapi
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+func NewRateLimiter(requestsPerSecond float64, burst int) *RateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &RateLimiter{
+		rate:   requestsPerSecond,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// Token bucket limiter, safe to share between clients and goroutines
+type RateLimiter struct {
+	lock      sync.Mutex
+	rate      float64
+	burst     float64
+	tokens    float64
+	last      time.Time
+	throttled time.Duration
+}
+
+func WithRateLimiter(limiter *RateLimiter) Option {
+	return func(c *Client) {
+		c.rateLimiter = limiter
+	}
+}
+
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	if l == nil || l.rate <= 0 {
+		return ctx.Err()
+	}
+
+	l.lock.Lock()
+	l.refill(time.Now())
+	//Reserve a token, a negative balance is the queue of waiting callers
+	l.tokens--
+	delay := time.Duration(0)
+	if l.tokens < 0 {
+		delay = time.Duration(-l.tokens / l.rate * float64(time.Second))
+	}
+	l.lock.Unlock()
+
+	if delay == 0 {
+		return nil
+	}
+
+	start := time.Now()
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		l.lock.Lock()
+		l.tokens++
+		l.throttled += time.Since(start)
+		l.lock.Unlock()
+
+		return ctx.Err()
+	case <-timer.C:
+		l.lock.Lock()
+		l.throttled += time.Since(start)
+		l.lock.Unlock()
+
+		return nil
+	}
+}
+
+func (l *RateLimiter) Throttled() time.Duration {
+	if l == nil {
+		return 0
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	return l.throttled
+}
+
+func (l *RateLimiter) refill(now time.Time) {
+	elapsed := now.Sub(l.last)
+	l.last = now
+
+	if elapsed <= 0 {
+		return
+	}
+
+	l.tokens += elapsed.Seconds() * l.rate
+
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+}
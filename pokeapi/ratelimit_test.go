@@ -0,0 +1,71 @@
+package pokeapi
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterBurstThenThrottle(t *testing.T) {
+	const rate = 100
+	limiter := NewRateLimiter(rate, 2)
+
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		if err := limiter.Wait(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	elapsed := time.Since(start)
+
+	//Two requests come from the burst, the other two wait 10ms each
+	if elapsed < 15*time.Millisecond {
+		t.Errorf("expected throttling, finished in %s", elapsed)
+	}
+	if limiter.Throttled() < 15*time.Millisecond {
+		t.Errorf("expected throttled time to be reported, got %s", limiter.Throttled())
+	}
+}
+
+func TestRateLimiterSharedAcrossGoroutines(t *testing.T) {
+	const rate = 200
+	const workers = 10
+	limiter := NewRateLimiter(rate, 1)
+
+	start := time.Now()
+	wg := sync.WaitGroup{}
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			limiter.Wait(context.Background())
+		}()
+	}
+	wg.Wait()
+	elapsed := time.Since(start)
+
+	minimum := time.Duration(workers-1) * time.Second / rate
+	if elapsed < minimum-5*time.Millisecond {
+		t.Errorf("expected at least %s, finished in %s", minimum, elapsed)
+	}
+}
+
+func TestRateLimiterContextCancellation(t *testing.T) {
+	limiter := NewRateLimiter(1, 1)
+	limiter.Wait(context.Background())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err := limiter.Wait(ctx)
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected context.DeadlineExceeded, got %v", err)
+	}
+	if time.Since(start) > 500*time.Millisecond {
+		t.Errorf("expected Wait to return on cancellation, took %s", time.Since(start))
+	}
+}
@@ -0,0 +1,82 @@
+package pokeapi
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	EndpointPokemon      = "pokemon"
+	EndpointLocationArea = "location-area"
+)
+
+func Get[T any](ctx context.Context, client *Client, endpoint string, idOrName string) (T, error) {
+	var resource T
+
+	err := client.getJSON(ctx, fmt.Sprintf("/%s/%s/", endpoint, idOrName), &resource)
+
+	return resource, err
+}
+
+func GetByURL[T any](ctx context.Context, client *Client, url string) (T, error) {
+	var resource T
+
+	if url == "" {
+		return resource, ErrEmptyURL
+	}
+
+	err := client.getURLJSON(ctx, url, &resource)
+
+	return resource, err
+}
+
+func List[T any](ctx context.Context, client *Client, endpoint string, limit int, offset int) (NamedAPIResourceList[T], error) {
+	return GetByURL[NamedAPIResourceList[T]](ctx, client, client.ListURL(endpoint, limit, offset))
+}
+
+func (c *Client) ListURL(endpoint string, limit int, offset int) string {
+	return fmt.Sprintf("%s/%s/?limit=%d&offset=%d", c.baseURL, endpoint, limit, offset)
+}
+
+// Link to another resource, T is the type it resolves to
+type NamedAPIResource[T any] struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+// Numeric ID from the end of the URL, 0 if there is none
+func (r NamedAPIResource[T]) ID() int {
+	return resourceID(r.URL)
+}
+
+func (r NamedAPIResource[T]) Resolve(ctx context.Context, client *Client) (T, error) {
+	return GetByURL[T](ctx, client, r.URL)
+}
+
+func resourceID(url string) int {
+	parts := strings.Split(strings.TrimRight(url, "/"), "/")
+	id, err := strconv.Atoi(parts[len(parts)-1])
+
+	if err != nil {
+		return 0
+	}
+
+	return id
+}
+
+type APIResource[T any] struct {
+	URL string `json:"url"`
+}
+
+func (r APIResource[T]) Resolve(ctx context.Context, client *Client) (T, error) {
+	return GetByURL[T](ctx, client, r.URL)
+}
+
+type NamedAPIResourceList[T any] struct {
+	Count    int                   `json:"count"`
+	Next     *string               `json:"next"`
+	Previous *string               `json:"previous"`
+	Results  []NamedAPIResource[T] `json:"results"`
+}
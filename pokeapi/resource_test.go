@@ -0,0 +1,41 @@
+package pokeapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetAndResolve(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/location-area/pastoria-city-area/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"id":1,"name":"pastoria-city-area","pokemon_encounters":[{"pokemon":{"name":"tentacool","url":"%s/pokemon/72/"}}]}`, server.URL)
+	})
+	mux.HandleFunc("/pokemon/72/", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id":72,"name":"tentacool","base_experience":67}`))
+	})
+
+	client := NewClient(WithBaseURL(server.URL))
+	ctx := context.Background()
+
+	area, err := Get[LocationArea](ctx, client, EndpointLocationArea, "pastoria-city-area")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if area.Name != "pastoria-city-area" || len(area.PokemonEncounters) != 1 {
+		t.Fatalf("unexpected location area: %+v", area)
+	}
+
+	pokemon, err := area.PokemonEncounters[0].Pokemon.Resolve(ctx, client)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if pokemon.ID != 72 || pokemon.Name != "tentacool" || pokemon.BaseExperience != 67 {
+		t.Errorf("unexpected pokemon: %+v", pokemon)
+	}
+}
@@ -0,0 +1,93 @@
+package pokeapi
+
+import (
+	"errors"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 4,
+	BaseDelay:   250 * time.Millisecond,
+	MaxDelay:    8 * time.Second,
+}
+
+type RetryPolicy struct {
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+}
+
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(c *Client) {
+		if policy.MaxAttempts < 1 {
+			policy.MaxAttempts = 1
+		}
+		c.retryPolicy = policy
+	}
+}
+
+// Full jitter: a random delay between zero and the exponential cap
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	if attempt > 30 {
+		attempt = 30
+	}
+
+	ceiling := p.BaseDelay << (attempt - 1)
+
+	if ceiling <= 0 || (p.MaxDelay > 0 && ceiling > p.MaxDelay) {
+		ceiling = p.MaxDelay
+	}
+
+	if ceiling <= 0 {
+		return 0
+	}
+
+	return time.Duration(rand.Int64N(int64(ceiling) + 1))
+}
+
+func isIdempotent(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead
+}
+
+func isRetryable(err error) bool {
+	var apiErr *APIError
+
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == http.StatusTooManyRequests || apiErr.StatusCode >= 500
+	}
+
+	//Anything else that is not an API error is a transport failure
+	return true
+}
+
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	seconds, err := strconv.Atoi(value)
+
+	if err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	date, err := http.ParseTime(value)
+
+	if err != nil {
+		return 0, false
+	}
+
+	delay := date.Sub(now)
+
+	if delay < 0 {
+		delay = 0
+	}
+
+	return delay, true
+}
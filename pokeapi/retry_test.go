@@ -0,0 +1,143 @@
+package pokeapi
+
+import (
+	"bytes"
+	"errors"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newFlakyServer(failures int, status int, header http.Header) (*httptest.Server, *atomic.Int32) {
+	requests := &atomic.Int32{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := requests.Add(1)
+
+		if int(n) <= failures {
+			for key, values := range header {
+				w.Header()[key] = values
+			}
+			w.WriteHeader(status)
+			return
+		}
+
+		w.Write([]byte(`{"name":"bulbasaur","base_experience":64}`))
+	}))
+
+	return server, requests
+}
+
+func TestRetry(t *testing.T) {
+	const attempts = 4
+	policy := RetryPolicy{MaxAttempts: attempts, BaseDelay: time.Millisecond, MaxDelay: 5 * time.Millisecond}
+
+	cases := []struct {
+		name         string
+		failures     int
+		status       int
+		header       http.Header
+		wantRequests int
+		wantErr      error
+	}{
+		{
+			name:         "recovers from 503",
+			failures:     2,
+			status:       http.StatusServiceUnavailable,
+			wantRequests: 3,
+		},
+		{
+			name:         "honors Retry-After on 429",
+			failures:     1,
+			status:       http.StatusTooManyRequests,
+			header:       http.Header{"Retry-After": []string{"0"}},
+			wantRequests: 2,
+		},
+		{
+			name:         "gives up on a Retry-After above MaxDelay",
+			failures:     1,
+			status:       http.StatusTooManyRequests,
+			header:       http.Header{"Retry-After": []string{"3600"}},
+			wantRequests: 1,
+			wantErr:      ErrRateLimited,
+		},
+		{
+			name:         "does not retry 404",
+			failures:     1,
+			status:       http.StatusNotFound,
+			wantRequests: 1,
+			wantErr:      ErrNotFound,
+		},
+		{
+			name:         "gives up after max attempts",
+			failures:     attempts,
+			status:       http.StatusTooManyRequests,
+			wantRequests: attempts,
+			wantErr:      ErrRateLimited,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			server, requests := newFlakyServer(c.failures, c.status, c.header)
+			defer server.Close()
+
+			debug := &bytes.Buffer{}
+			client := NewClient(
+				WithBaseURL(server.URL),
+				WithRetryPolicy(policy),
+				WithDebugLogger(log.New(debug, "", 0)),
+			)
+
+			pokemon, err := client.GetPokemonToCatch("bulbasaur")
+
+			if c.wantErr != nil {
+				if !errors.Is(err, c.wantErr) {
+					t.Errorf("expected %v, got %v", c.wantErr, err)
+				}
+			} else if err != nil || pokemon.Name != "bulbasaur" {
+				t.Errorf("expected bulbasaur, got %+v, %v", pokemon, err)
+			}
+
+			if int(requests.Load()) != c.wantRequests {
+				t.Errorf("expected %d requests, got %d", c.wantRequests, requests.Load())
+			}
+
+			retries := strings.Count(debug.String(), "retrying in")
+			if retries != c.wantRequests-1 {
+				t.Errorf("expected %d retries in debug output, got %d:\n%s", c.wantRequests-1, retries, debug.String())
+			}
+
+			if c.wantErr != nil && strings.Count(debug.String(), "giving up") != 1 {
+				t.Errorf("expected the final failed attempt in debug output:\n%s", debug.String())
+			}
+		})
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 4, 9, 12, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		value string
+		want  time.Duration
+		ok    bool
+	}{
+		{value: "", ok: false},
+		{value: "3", want: 3 * time.Second, ok: true},
+		{value: "Tue, 09 Apr 2024 12:00:10 GMT", want: 10 * time.Second, ok: true},
+		{value: "Tue, 09 Apr 2024 11:00:00 GMT", want: 0, ok: true},
+		{value: "soon", ok: false},
+	}
+
+	for _, c := range cases {
+		got, ok := parseRetryAfter(c.value, now)
+		if ok != c.ok || got != c.want {
+			t.Errorf("parseRetryAfter(%q) = %v, %v; want %v, %v", c.value, got, ok, c.want, c.ok)
+		}
+	}
+}
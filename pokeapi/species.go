@@ -0,0 +1,93 @@
+package pokeapi
+
+import (
+	"context"
+	"strings"
+)
+
+const EndpointPokemonSpecies = "pokemon-species"
+
+func (c *Client) GetPokemonSpecies(idOrName string) (PokemonSpecies, error) {
+	return c.GetPokemonSpeciesContext(context.Background(), idOrName)
+}
+
+func (c *Client) GetPokemonSpeciesContext(ctx context.Context, idOrName string) (PokemonSpecies, error) {
+	return Get[PokemonSpecies](ctx, c, EndpointPokemonSpecies, idOrName)
+}
+
+// Most recent flavor text in the given language, with the game's line breaks removed
+func (s PokemonSpecies) FlavorText(language string) string {
+	flavorText := ""
+
+	for _, entry := range s.FlavorTextEntries {
+		if entry.Language.Name == language {
+			flavorText = entry.FlavorText
+		}
+	}
+
+	return strings.Join(strings.Fields(flavorText), " ")
+}
+
+func (s PokemonSpecies) Genus(language string) string {
+	for _, genus := range s.Genera {
+		if genus.Language.Name == language {
+			return genus.Genus
+		}
+	}
+
+	return ""
+}
+
+// Chance of being female, gender_rate is given in eighths and -1 means genderless
+func (s PokemonSpecies) FemaleRatio() (float64, bool) {
+	if s.GenderRate < 0 {
+		return 0, false
+	}
+
+	return float64(s.GenderRate) / 8, true
+}
+
+type PokemonSpecies struct {
+	ID                   int                               `json:"id"`
+	Name                 string                            `json:"name"`
+	Order                int                               `json:"order"`
+	GenderRate           int                               `json:"gender_rate"`
+	CaptureRate          int                               `json:"capture_rate"`
+	BaseHappiness        int                               `json:"base_happiness"`
+	IsBaby               bool                              `json:"is_baby"`
+	IsLegendary          bool                              `json:"is_legendary"`
+	IsMythical           bool                              `json:"is_mythical"`
+	HatchCounter         int                               `json:"hatch_counter"`
+	HasGenderDifferences bool                              `json:"has_gender_differences"`
+	GrowthRate           NamedAPIResource[any]             `json:"growth_rate"`
+	EggGroups            []NamedAPIResource[any]           `json:"egg_groups"`
+	Color                NamedAPIResource[any]             `json:"color"`
+	Shape                NamedAPIResource[any]             `json:"shape"`
+	Habitat              *NamedAPIResource[any]            `json:"habitat"`
+	Generation           NamedAPIResource[any]             `json:"generation"`
+	EvolvesFromSpecies   *NamedAPIResource[PokemonSpecies] `json:"evolves_from_species"`
+	EvolutionChain       APIResource[EvolutionChain]       `json:"evolution_chain"`
+	Names                []Name                            `json:"names"`
+	FlavorTextEntries    []FlavorText                      `json:"flavor_text_entries"`
+	Genera               []Genus                           `json:"genera"`
+	Varieties            []struct {
+		IsDefault bool                          `json:"is_default"`
+		Pokemon   NamedAPIResource[PokemonData] `json:"pokemon"`
+	} `json:"varieties"`
+}
+
+type Name struct {
+	Name     string                `json:"name"`
+	Language NamedAPIResource[any] `json:"language"`
+}
+
+type FlavorText struct {
+	FlavorText string                `json:"flavor_text"`
+	Language   NamedAPIResource[any] `json:"language"`
+	Version    NamedAPIResource[any] `json:"version"`
+}
+
+type Genus struct {
+	Genus    string                `json:"genus"`
+	Language NamedAPIResource[any] `json:"language"`
+}
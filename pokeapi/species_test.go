@@ -0,0 +1,82 @@
+package pokeapi
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetPokemonToCatchWithSpecies(t *testing.T) {
+	speciesRequests := 0
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/pokemon/bulbasaur/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"name":"bulbasaur","base_experience":64,"species":{"name":"bulbasaur","url":"%s/pokemon-species/1/"}}`, server.URL)
+	})
+	mux.HandleFunc("/pokemon-species/1/", func(w http.ResponseWriter, r *http.Request) {
+		speciesRequests++
+		w.Write([]byte(`{
+			"name": "bulbasaur",
+			"capture_rate": 45,
+			"gender_rate": 1,
+			"genera": [
+				{"genus": "たねポケモン", "language": {"name": "ja"}},
+				{"genus": "Seed Pokémon", "language": {"name": "en"}}
+			],
+			"flavor_text_entries": [
+				{"flavor_text": "A strange seed was\nplanted on its\fback at birth.", "language": {"name": "en"}, "version": {"name": "red"}},
+				{"flavor_text": "Il a une étrange graine.", "language": {"name": "fr"}, "version": {"name": "x"}},
+				{"flavor_text": "There is a plant seed\non its back right\nfrom the day this\fPOKéMON is born.", "language": {"name": "en"}, "version": {"name": "yellow"}}
+			]
+		}`))
+	})
+
+	client := NewClient(WithBaseURL(server.URL))
+
+	pokemon, err := client.GetPokemonToCatch("bulbasaur")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if speciesRequests != 0 || pokemon.Species != "bulbasaur" || pokemon.CaptureRate != 0 {
+		t.Errorf("expected no species request without asking for it, got %d requests and %+v", speciesRequests, pokemon)
+	}
+
+	pokemon, err = client.GetPokemonToCatchWithSpecies("bulbasaur")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if pokemon.CaptureRate != 45 {
+		t.Errorf("expected capture rate 45, got %d", pokemon.CaptureRate)
+	}
+	if pokemon.Genus != "Seed Pokémon" {
+		t.Errorf("expected genus Seed Pokémon, got %q", pokemon.Genus)
+	}
+	if pokemon.FlavorText != "There is a plant seed on its back right from the day this POKéMON is born." {
+		t.Errorf("unexpected flavor text %q", pokemon.FlavorText)
+	}
+}
+
+func TestFemaleRatio(t *testing.T) {
+	cases := []struct {
+		genderRate int
+		ratio      float64
+		ok         bool
+	}{
+		{genderRate: -1, ratio: 0, ok: false},
+		{genderRate: 0, ratio: 0, ok: true},
+		{genderRate: 1, ratio: 0.125, ok: true},
+		{genderRate: 8, ratio: 1, ok: true},
+	}
+
+	for _, c := range cases {
+		ratio, ok := PokemonSpecies{GenderRate: c.genderRate}.FemaleRatio()
+		if ratio != c.ratio || ok != c.ok {
+			t.Errorf("gender_rate %d: expected %v, %v; got %v, %v", c.genderRate, c.ratio, c.ok, ratio, ok)
+		}
+	}
+}
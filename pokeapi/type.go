@@ -0,0 +1,56 @@
+package pokeapi
+
+import "context"
+
+const EndpointType = "type"
+
+func (c *Client) GetType(name string) (Type, error) {
+	return c.GetTypeContext(context.Background(), name)
+}
+
+func (c *Client) GetTypeContext(ctx context.Context, name string) (Type, error) {
+	return Get[Type](ctx, c, EndpointType, name)
+}
+
+func (c *Client) GetTypeChart(names ...string) (TypeChart, error) {
+	return c.GetTypeChartContext(context.Background(), names...)
+}
+
+func (c *Client) GetTypeChartContext(ctx context.Context, names ...string) (TypeChart, error) {
+	chart := NewTypeChart()
+
+	for _, name := range names {
+		pType, err := c.GetTypeContext(ctx, name)
+
+		if err != nil {
+			return chart, err
+		}
+
+		chart.Add(pType)
+	}
+
+	return chart, nil
+}
+
+type Type struct {
+	ID              int                    `json:"id"`
+	Name            string                 `json:"name"`
+	DamageRelations TypeRelations          `json:"damage_relations"`
+	Generation      NamedAPIResource[any]  `json:"generation"`
+	MoveDamageClass *NamedAPIResource[any] `json:"move_damage_class"`
+	Names           []Name                 `json:"names"`
+	Pokemon         []struct {
+		Slot    int                           `json:"slot"`
+		Pokemon NamedAPIResource[PokemonData] `json:"pokemon"`
+	} `json:"pokemon"`
+	Moves []NamedAPIResource[any] `json:"moves"`
+}
+
+type TypeRelations struct {
+	NoDamageTo       []NamedAPIResource[Type] `json:"no_damage_to"`
+	HalfDamageTo     []NamedAPIResource[Type] `json:"half_damage_to"`
+	DoubleDamageTo   []NamedAPIResource[Type] `json:"double_damage_to"`
+	NoDamageFrom     []NamedAPIResource[Type] `json:"no_damage_from"`
+	HalfDamageFrom   []NamedAPIResource[Type] `json:"half_damage_from"`
+	DoubleDamageFrom []NamedAPIResource[Type] `json:"double_damage_from"`
+}
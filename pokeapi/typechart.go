@@ -0,0 +1,82 @@
+package pokeapi
+
+func NewTypeChart(types ...Type) TypeChart {
+	chart := TypeChart{
+		multipliers: make(map[string]map[string]float64),
+	}
+
+	for _, pType := range types {
+		chart.Add(pType)
+	}
+
+	return chart
+}
+
+// Damage multipliers by attacking and defending type, pairs that were never
+// loaded count as neutral
+type TypeChart struct {
+	multipliers map[string]map[string]float64
+}
+
+// Records the type's relations both as an attacker and as a defender
+func (t TypeChart) Add(pType Type) {
+	relations := pType.DamageRelations
+
+	for _, defender := range relations.NoDamageTo {
+		t.set(pType.Name, defender.Name, 0)
+	}
+	for _, defender := range relations.HalfDamageTo {
+		t.set(pType.Name, defender.Name, 0.5)
+	}
+	for _, defender := range relations.DoubleDamageTo {
+		t.set(pType.Name, defender.Name, 2)
+	}
+	for _, attacker := range relations.NoDamageFrom {
+		t.set(attacker.Name, pType.Name, 0)
+	}
+	for _, attacker := range relations.HalfDamageFrom {
+		t.set(attacker.Name, pType.Name, 0.5)
+	}
+	for _, attacker := range relations.DoubleDamageFrom {
+		t.set(attacker.Name, pType.Name, 2)
+	}
+}
+
+func (t TypeChart) Multiplier(attack string, defenders ...string) float64 {
+	multiplier := 1.0
+
+	for _, defender := range defenders {
+		value, ok := t.multipliers[attack][defender]
+
+		if ok {
+			multiplier *= value
+		}
+	}
+
+	return multiplier
+}
+
+// Every known attacking type that is not neutral against the defender
+func (t TypeChart) Weaknesses(defenders ...string) map[string]float64 {
+	weaknesses := make(map[string]float64)
+
+	for attack := range t.multipliers {
+		multiplier := t.Multiplier(attack, defenders...)
+
+		if multiplier != 1 {
+			weaknesses[attack] = multiplier
+		}
+	}
+
+	return weaknesses
+}
+
+func (t TypeChart) set(attack string, defender string, multiplier float64) {
+	_, ok := t.multipliers[attack]
+
+	if !ok {
+		t.multipliers[attack] = make(map[string]float64)
+	}
+
+	t.multipliers[attack][defender] = multiplier
+}
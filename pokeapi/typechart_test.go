@@ -0,0 +1,79 @@
+package pokeapi
+
+import (
+	"reflect"
+	"testing"
+)
+
+func resources(names ...string) []NamedAPIResource[Type] {
+	list := make([]NamedAPIResource[Type], 0, len(names))
+	for _, name := range names {
+		list = append(list, NamedAPIResource[Type]{Name: name})
+	}
+	return list
+}
+
+func TestTypeChartMultiplier(t *testing.T) {
+	water := Type{Name: "water", DamageRelations: TypeRelations{
+		HalfDamageTo:   resources("water", "grass", "dragon"),
+		DoubleDamageTo: resources("ground", "rock", "fire"),
+	}}
+	electric := Type{Name: "electric", DamageRelations: TypeRelations{
+		NoDamageTo:     resources("ground"),
+		HalfDamageTo:   resources("electric", "grass", "dragon"),
+		DoubleDamageTo: resources("flying", "water"),
+	}}
+
+	chart := NewTypeChart(water, electric)
+
+	cases := []struct {
+		attack    string
+		defenders []string
+		want      float64
+	}{
+		{attack: "water", defenders: []string{"fire"}, want: 2},
+		{attack: "water", defenders: []string{"rock", "ground"}, want: 4},
+		{attack: "water", defenders: []string{"fire", "dragon"}, want: 1},
+		{attack: "water", defenders: []string{"normal"}, want: 1},
+		{attack: "electric", defenders: []string{"water", "ground"}, want: 0},
+		{attack: "electric", defenders: []string{"grass", "dragon"}, want: 0.25},
+		{attack: "normal", defenders: []string{"ghost"}, want: 1},
+	}
+
+	for _, c := range cases {
+		if got := chart.Multiplier(c.attack, c.defenders...); got != c.want {
+			t.Errorf("%s vs %v: expected %v, got %v", c.attack, c.defenders, c.want, got)
+		}
+	}
+}
+
+func TestTypeChartWeaknesses(t *testing.T) {
+	fire := Type{Name: "fire", DamageRelations: TypeRelations{
+		DoubleDamageFrom: resources("ground", "rock", "water"),
+		HalfDamageFrom:   resources("bug", "steel", "fire", "grass", "ice", "fairy"),
+	}}
+	flying := Type{Name: "flying", DamageRelations: TypeRelations{
+		NoDamageFrom:     resources("ground"),
+		DoubleDamageFrom: resources("rock", "electric", "ice"),
+		HalfDamageFrom:   resources("fighting", "bug", "grass"),
+	}}
+
+	chart := NewTypeChart(fire, flying)
+
+	want := map[string]float64{
+		"rock":     4,
+		"water":    2,
+		"electric": 2,
+		"ground":   0,
+		"bug":      0.25,
+		"grass":    0.25,
+		"steel":    0.5,
+		"fire":     0.5,
+		"fairy":    0.5,
+		"fighting": 0.5,
+	}
+
+	if got := chart.Weaknesses("fire", "flying"); !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected weaknesses of fire/flying:\n got %v\nwant %v", got, want)
+	}
+}
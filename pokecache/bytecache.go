@@ -0,0 +1,29 @@
+package pokecache
+
+import "time"
+
+// Byte-oriented cache of the original API, keyed by URL. Entries expire
+// after interval
+func NewCache(interval time.Duration, opts ...Option[string, []byte]) *ByteCache {
+	return &ByteCache{New[string, []byte](interval, opts...)}
+}
+
+// WithMaxBytes for a ByteCache, measuring the length of the values
+func WithMaxValueBytes(n int) Option[string, []byte] {
+	return WithMaxBytes[string](n, func(val []byte) int { return len(val) })
+}
+
+type ByteCache struct {
+	*Cache[string, []byte]
+}
+
+// A miss returns an empty slice rather than nil
+func (c *ByteCache) Get(key string) ([]byte, bool) {
+	val, ok := c.Cache.Get(key)
+
+	if !ok {
+		return make([]byte, 0), ok
+	}
+
+	return val, ok
+}
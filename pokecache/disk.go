@@ -0,0 +1,425 @@
+package pokecache
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	diskMagic     = "PKC1"
+	diskExtension = ".entry"
+	//Magic, checksum of the rest, expiry in unix nanoseconds and key length
+	diskHeaderSize = len(diskMagic) + sha256.Size + 8 + 4
+	//A full store is compacted to this share of its cap, so compaction
+	//with its directory walk only runs once every few writes
+	diskLowWaterPercent = 80
+	//Other processes may share the directory, so only temporary files
+	//older than any write in progress are left over from a crash
+	staleTempAge = time.Hour
+)
+
+var errCorruptEntry = errors.New("pokecache: corrupt disk entry")
+
+// Opens a directory of cache entries, one file per key, creating it when
+// needed. Once the files take more than maxBytes the least recently used
+// are removed, 0 means no limit
+func OpenDiskStore(dir string, maxBytes int64) (*DiskStore, error) {
+	err := os.MkdirAll(dir, 0o755)
+
+	if err != nil {
+		return nil, err
+	}
+
+	store := &DiskStore{
+		dir:      dir,
+		maxBytes: maxBytes,
+	}
+
+	err = store.Compact()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return store, nil
+}
+
+// Second tier of a Cache that survives restarts. Files are written to a
+// temporary file and renamed, and checked against a checksum when read,
+// so a crash never leaves a readable half-written entry
+type DiskStore struct {
+	dir      string
+	maxBytes int64
+
+	lock  sync.Mutex
+	bytes int64
+}
+
+func (d *DiskStore) Dir() string {
+	return d.dir
+}
+
+// Total size of the entry files
+func (d *DiskStore) Bytes() int64 {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	return d.bytes
+}
+
+// A zero expiresAt keeps the entry until it is deleted or compacted away
+func (d *DiskStore) Set(key string, val []byte, expiresAt time.Time) error {
+	data := encodeDiskEntry(key, val, expiresAt)
+	path := d.path(key)
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	oldSize := int64(0)
+	info, err := os.Stat(path)
+
+	if err == nil {
+		oldSize = info.Size()
+	}
+
+	err = d.writeEntry(path, data)
+
+	if err != nil {
+		return err
+	}
+
+	d.bytes += int64(len(data)) - oldSize
+
+	if d.maxBytes > 0 && d.bytes > d.maxBytes {
+		return d.compact(d.maxBytes * diskLowWaterPercent / 100)
+	}
+
+	return nil
+}
+
+// Entries are written beside their final path and renamed over it, a
+// failed write removes its temporary file instead of leaving it for
+// compaction
+func (d *DiskStore) writeEntry(path string, data []byte) error {
+	tmp, err := os.CreateTemp(d.dir, "*.tmp")
+
+	if err != nil {
+		return err
+	}
+
+	_, err = tmp.Write(data)
+
+	if err == nil {
+		err = tmp.Sync()
+	}
+
+	closeErr := tmp.Close()
+
+	if err == nil {
+		err = closeErr
+	}
+
+	if err == nil {
+		err = os.Rename(tmp.Name(), path)
+	}
+
+	if err != nil {
+		os.Remove(tmp.Name())
+	}
+
+	return err
+}
+
+// Expired and corrupt entries are removed and reported as misses
+func (d *DiskStore) Get(key string) ([]byte, time.Time, bool) {
+	path := d.path(key)
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	data, err := os.ReadFile(path)
+
+	if err != nil {
+		return nil, time.Time{}, false
+	}
+
+	storedKey, val, expiresAt, err := decodeDiskEntry(data)
+
+	if err != nil || expired(expiresAt, time.Now()) {
+		d.removeFile(path, int64(len(data)))
+		return nil, time.Time{}, false
+	}
+
+	if storedKey != key {
+		return nil, time.Time{}, false
+	}
+
+	//Recently read entries survive compaction the longest
+	now := time.Now()
+	os.Chtimes(path, now, now)
+
+	return val, expiresAt, true
+}
+
+func (d *DiskStore) Delete(key string) error {
+	path := d.path(key)
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	info, err := os.Stat(path)
+
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return d.removeFile(path, info.Size())
+}
+
+func (d *DiskStore) Clear() error {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	files, err := d.files()
+
+	if err != nil {
+		return err
+	}
+
+	for _, file := range files {
+		err = d.removeFile(file.path, file.size)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Removes stale temporary files and expired entries, then the least
+// recently used entries until the store fits in its size cap
+func (d *DiskStore) Compact() error {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	return d.compact(d.maxBytes)
+}
+
+type diskFile struct {
+	path    string
+	size    int64
+	modTime time.Time
+}
+
+// Trims the store to limit bytes, 0 keeps every live entry.
+// Must be called with the lock held
+func (d *DiskStore) compact(limit int64) error {
+	temps, err := filepath.Glob(filepath.Join(d.dir, "*.tmp"))
+
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+
+	for _, temp := range temps {
+		info, err := os.Stat(temp)
+
+		if err == nil && now.Sub(info.ModTime()) > staleTempAge {
+			os.Remove(temp)
+		}
+	}
+
+	files, err := d.files()
+
+	if err != nil {
+		return err
+	}
+
+	d.bytes = 0
+	live := files[:0]
+
+	for _, file := range files {
+		expiresAt, err := readDiskExpiry(file.path)
+
+		if err != nil || expired(expiresAt, now) {
+			os.Remove(file.path)
+			continue
+		}
+
+		d.bytes += file.size
+		live = append(live, file)
+	}
+
+	if limit <= 0 {
+		return nil
+	}
+
+	sort.Slice(live, func(i, j int) bool {
+		return live[i].modTime.Before(live[j].modTime)
+	})
+
+	for _, file := range live {
+		if d.bytes <= limit {
+			break
+		}
+
+		err = d.removeFile(file.path, file.size)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Must be called with the lock held
+func (d *DiskStore) files() ([]diskFile, error) {
+	entries, err := os.ReadDir(d.dir)
+
+	if err != nil {
+		return nil, err
+	}
+
+	files := make([]diskFile, 0, len(entries))
+
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), diskExtension) {
+			continue
+		}
+
+		info, err := entry.Info()
+
+		if err != nil {
+			continue
+		}
+
+		files = append(files, diskFile{
+			path:    filepath.Join(d.dir, entry.Name()),
+			size:    info.Size(),
+			modTime: info.ModTime(),
+		})
+	}
+
+	return files, nil
+}
+
+// Must be called with the lock held
+func (d *DiskStore) removeFile(path string, size int64) error {
+	err := os.Remove(path)
+
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	if err == nil {
+		d.bytes -= size
+	}
+
+	return nil
+}
+
+// Keys can hold any character, so files are named by their hash
+func (d *DiskStore) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+
+	return filepath.Join(d.dir, hex.EncodeToString(sum[:])+diskExtension)
+}
+
+func expired(expiresAt time.Time, now time.Time) bool {
+	return !expiresAt.IsZero() && !now.Before(expiresAt)
+}
+
+func encodeDiskEntry(key string, val []byte, expiresAt time.Time) []byte {
+	expiry := int64(0)
+
+	if !expiresAt.IsZero() {
+		expiry = expiresAt.UnixNano()
+	}
+
+	body := make([]byte, 0, 12+len(key)+len(val))
+	body = binary.BigEndian.AppendUint64(body, uint64(expiry))
+	body = binary.BigEndian.AppendUint32(body, uint32(len(key)))
+	body = append(body, key...)
+	body = append(body, val...)
+
+	sum := sha256.Sum256(body)
+
+	data := make([]byte, 0, diskHeaderSize+len(key)+len(val))
+	data = append(data, diskMagic...)
+	data = append(data, sum[:]...)
+
+	return append(data, body...)
+}
+
+func decodeDiskEntry(data []byte) (string, []byte, time.Time, error) {
+	if len(data) < diskHeaderSize || string(data[:len(diskMagic)]) != diskMagic {
+		return "", nil, time.Time{}, errCorruptEntry
+	}
+
+	sum := data[len(diskMagic) : len(diskMagic)+sha256.Size]
+	body := data[len(diskMagic)+sha256.Size:]
+	bodySum := sha256.Sum256(body)
+
+	if !bytes.Equal(sum, bodySum[:]) {
+		return "", nil, time.Time{}, errCorruptEntry
+	}
+
+	expiresAt := decodeDiskExpiry(body)
+	keyLength := int(binary.BigEndian.Uint32(body[8:12]))
+
+	if 12+keyLength > len(body) {
+		return "", nil, time.Time{}, errCorruptEntry
+	}
+
+	key := string(body[12 : 12+keyLength])
+
+	return key, body[12+keyLength:], expiresAt, nil
+}
+
+func decodeDiskExpiry(body []byte) time.Time {
+	expiry := int64(binary.BigEndian.Uint64(body[:8]))
+
+	if expiry == 0 {
+		return time.Time{}
+	}
+
+	return time.Unix(0, expiry)
+}
+
+// Reads only the header, the checksum is checked when the entry is read
+func readDiskExpiry(path string) (time.Time, error) {
+	file, err := os.Open(path)
+
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	defer file.Close()
+
+	header := make([]byte, diskHeaderSize)
+	_, err = io.ReadFull(file, header)
+
+	if err != nil || string(header[:len(diskMagic)]) != diskMagic {
+		return time.Time{}, errCorruptEntry
+	}
+
+	return decodeDiskExpiry(header[len(diskMagic)+sha256.Size:]), nil
+}
@@ -0,0 +1,241 @@
+package pokecache
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestDiskStoreSetGet(t *testing.T) {
+	store, err := OpenDiskStore(t.TempDir(), 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expiresAt := time.Now().Add(time.Hour)
+
+	err = store.Set("https://pokeapi.co/api/v2/pokemon/pikachu/", []byte("pikachu"), expiresAt)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	val, gotExpiry, ok := store.Get("https://pokeapi.co/api/v2/pokemon/pikachu/")
+	if !ok || string(val) != "pikachu" || !gotExpiry.Equal(expiresAt) {
+		t.Errorf("unexpected entry %q expiring %v", val, gotExpiry)
+	}
+
+	_, _, ok = store.Get("https://pokeapi.co/api/v2/pokemon/eevee/")
+	if ok {
+		t.Errorf("expected a miss for an unknown key")
+	}
+}
+
+func TestDiskStoreKeepsExpiryAcrossRestarts(t *testing.T) {
+	dir := t.TempDir()
+	store, _ := OpenDiskStore(dir, 0)
+
+	store.Set("short", []byte("1"), time.Now().Add(5*time.Millisecond))
+	store.Set("long", []byte("2"), time.Now().Add(time.Hour))
+	store.Set("forever", []byte("3"), time.Time{})
+
+	time.Sleep(10 * time.Millisecond)
+
+	reopened, err := OpenDiskStore(dir, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	_, _, ok := reopened.Get("short")
+	if ok {
+		t.Errorf("expected the expired entry to miss after a restart")
+	}
+
+	for _, key := range []string{"long", "forever"} {
+		_, _, ok = reopened.Get(key)
+		if !ok {
+			t.Errorf("expected %s to survive a restart", key)
+		}
+	}
+}
+
+func TestDiskStoreDetectsCorruption(t *testing.T) {
+	dir := t.TempDir()
+	store, _ := OpenDiskStore(dir, 0)
+	store.Set("pikachu", []byte("electric mouse"), time.Time{})
+
+	path := store.path("pikachu")
+	data, _ := os.ReadFile(path)
+	data[len(data)-1] ^= 0xff
+	os.WriteFile(path, data, 0o644)
+
+	_, _, ok := store.Get("pikachu")
+	if ok {
+		t.Errorf("expected a corrupt entry to miss")
+	}
+
+	_, err := os.Stat(path)
+	if !os.IsNotExist(err) {
+		t.Errorf("expected the corrupt entry to be removed, got %v", err)
+	}
+
+	//A crash between writing and renaming leaves only a temporary file,
+	//a recent one may still be written by another process
+	stale := filepath.Join(dir, "123.tmp")
+	os.WriteFile(stale, []byte("PKC1"), 0o644)
+	old := time.Now().Add(-2 * staleTempAge)
+	os.Chtimes(stale, old, old)
+
+	inFlight := filepath.Join(dir, "456.tmp")
+	os.WriteFile(inFlight, []byte("PKC1"), 0o644)
+
+	_, err = OpenDiskStore(dir, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	_, err = os.Stat(stale)
+	if !os.IsNotExist(err) {
+		t.Errorf("expected the stale temporary file to be removed, got %v", err)
+	}
+
+	_, err = os.Stat(inFlight)
+	if err != nil {
+		t.Errorf("expected a recent temporary file to stay, got %v", err)
+	}
+}
+
+func TestDiskStoreSizeCap(t *testing.T) {
+	store, _ := OpenDiskStore(t.TempDir(), 0)
+	store.Set("a", make([]byte, 100), time.Time{})
+	entrySize := store.Bytes()
+
+	capped, _ := OpenDiskStore(t.TempDir(), 2*entrySize)
+	old := time.Now().Add(-time.Hour)
+
+	capped.Set("a", make([]byte, 100), time.Time{})
+	os.Chtimes(capped.path("a"), old, old)
+	capped.Set("b", make([]byte, 100), time.Time{})
+	os.Chtimes(capped.path("b"), old.Add(time.Minute), old.Add(time.Minute))
+	capped.Set("c", make([]byte, 100), time.Time{})
+
+	//Going over the cap compacts down to the low-water mark, not just under the cap
+	if capped.Bytes() > 2*entrySize*diskLowWaterPercent/100 {
+		t.Errorf("expected at most %d bytes, got %d", 2*entrySize*diskLowWaterPercent/100, capped.Bytes())
+	}
+
+	_, _, ok := capped.Get("b")
+	if ok {
+		t.Errorf("expected compaction to free room below the cap")
+	}
+
+	_, _, ok = capped.Get("a")
+	if ok {
+		t.Errorf("expected the least recently used entry to be compacted away")
+	}
+
+	_, _, ok = capped.Get("c")
+	if !ok {
+		t.Errorf("expected the newest entry to stay")
+	}
+}
+
+func TestCacheReadsThroughToDisk(t *testing.T) {
+	type pokemon struct {
+		Name        string
+		CaptureRate int
+	}
+
+	store, _ := OpenDiskStore(t.TempDir(), 0)
+
+	cache := New[string, pokemon](time.Hour, WithDisk[string, pokemon](store, 24*time.Hour))
+	cache.Set("pikachu", pokemon{Name: "pikachu", CaptureRate: 190})
+	cache.Set("eevee", pokemon{Name: "eevee", CaptureRate: 45})
+	cache.Delete("eevee")
+	cache.Close()
+
+	//A new cache over the same directory stands in for the next session
+	restarted := New[string, pokemon](time.Hour, WithDisk[string, pokemon](store, 24*time.Hour))
+	defer restarted.Close()
+
+	val, ok := restarted.Get("pikachu")
+	if !ok || val.CaptureRate != 190 {
+		t.Errorf("expected pikachu from disk, got %+v", val)
+	}
+
+	if restarted.Len() != 1 {
+		t.Errorf("expected the disk hit to be kept in memory, got %d entries", restarted.Len())
+	}
+
+	_, ok = restarted.Get("eevee")
+	if ok {
+		t.Errorf("expected a deleted entry to be gone from disk too")
+	}
+}
+
+func TestDiskReadDoesNotOverwriteNewerSet(t *testing.T) {
+	store, _ := OpenDiskStore(t.TempDir(), 0)
+	cache := New[string, int](time.Hour, WithDisk[string, int](store, 0))
+	defer cache.Close()
+
+	store.Set("pikachu", []byte("1"), time.Time{})
+
+	//A Set that lands between a memory miss and the disk read finishing
+	gen := cache.gen
+	cache.store("pikachu", 2, time.Time{})
+
+	val, ok := cache.getFromDisk("pikachu", gen)
+	if !ok || val != 2 {
+		t.Errorf("expected the newer value 2, got %d, %v", val, ok)
+	}
+
+	val, _ = cache.Get("pikachu")
+	if val != 2 {
+		t.Errorf("expected the stale disk value not to be stored, got %d", val)
+	}
+}
+
+func TestDiskReadDoesNotRestoreDeletedValue(t *testing.T) {
+	store, _ := OpenDiskStore(t.TempDir(), 0)
+	cache := New[string, int](time.Hour, WithDisk[string, int](store, 0))
+	defer cache.Close()
+
+	cache.Set("pikachu", 1)
+	cache.Set("eevee", 1)
+
+	//A Delete and a Clear that land between the disk read and storing what it read
+	gen := cache.gen
+	cache.Delete("pikachu")
+	cache.storeIfAbsent("pikachu", 1, time.Time{}, gen)
+
+	_, ok := cache.Get("pikachu")
+	if ok {
+		t.Errorf("expected a deleted value not to come back")
+	}
+
+	gen = cache.gen
+	cache.Clear()
+	cache.storeIfAbsent("eevee", 1, time.Time{}, gen)
+
+	_, ok = cache.Get("eevee")
+	if ok {
+		t.Errorf("expected a cleared value not to come back")
+	}
+}
+
+func TestOverlappingSetsKeepTheNewerValueOnDisk(t *testing.T) {
+	store, _ := OpenDiskStore(t.TempDir(), 0)
+	cache := New[string, int](time.Hour, WithDisk[string, int](store, 0))
+	defer cache.Close()
+
+	//Two Sets whose disk writes finish in the opposite order of their changes
+	older, _ := cache.store("pikachu", 1, time.Time{})
+	newer, _ := cache.store("pikachu", 2, time.Time{})
+	cache.setOnDisk("pikachu", 2, time.Time{}, newer)
+	cache.setOnDisk("pikachu", 1, time.Time{}, older)
+
+	data, _, ok := store.Get("pikachu")
+	if !ok || string(data) != "2" {
+		t.Errorf("expected the newer value on disk, got %q, %v", data, ok)
+	}
+}
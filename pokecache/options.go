@@ -0,0 +1,69 @@
+package pokecache
+
+import (
+	"context"
+	"time"
+)
+
+type Option[K comparable, V any] func(*Cache[K, V])
+
+// Least recently used entries are evicted beyond n entries, 0 means no limit
+func WithMaxEntries[K comparable, V any](n int) Option[K, V] {
+	return func(c *Cache[K, V]) {
+		c.maxEntries = n
+	}
+}
+
+// Least recently used entries are evicted beyond n bytes as measured by
+// size, 0 means no limit
+func WithMaxBytes[K comparable, V any](n int, size func(val V) int) Option[K, V] {
+	return func(c *Cache[K, V]) {
+		c.maxBytes = n
+		c.sizeFunc = size
+	}
+}
+
+// The cache closes itself once ctx is done
+func WithContext[K comparable, V any](ctx context.Context) Option[K, V] {
+	return func(c *Cache[K, V]) {
+		c.ctx = ctx
+	}
+}
+
+// Adds a second tier that survives restarts, values are stored as JSON
+// and keys as formatted by fmt.Sprint. Entries stay on disk for ttl, 0
+// keeps the expiry they have in memory
+func WithDisk[K comparable, V any](store *DiskStore, ttl time.Duration) Option[K, V] {
+	return func(c *Cache[K, V]) {
+		c.disk = store
+		c.diskTTL = ttl
+	}
+}
+
+// Called after an entry leaves the cache, outside of the cache's lock
+func WithOnEvict[K comparable, V any](onEvict func(key K, val V, reason EvictionReason)) Option[K, V] {
+	return func(c *Cache[K, V]) {
+		c.onEvict = onEvict
+	}
+}
+
+type EvictionReason int
+
+const (
+	EvictionExpired EvictionReason = iota
+	EvictionCapacity
+	EvictionManual
+)
+
+func (r EvictionReason) String() string {
+	switch r {
+	case EvictionExpired:
+		return "expired"
+	case EvictionCapacity:
+		return "capacity"
+	case EvictionManual:
+		return "manual"
+	}
+
+	return "unknown"
+}
@@ -1,69 +1,570 @@
 package pokecache
 
 import (
+	"container/list"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"sync"
 	"time"
 )
 
-func NewCache(interval time.Duration) Cache {
-	cache := Cache{
-		interval: interval,
-		data:     make(map[string]cacheEntry),
-		lock:     &sync.Mutex{},
+// Returned by the calls that change a cache after Close
+var ErrClosed = errors.New("pokecache: cache is closed")
+
+// Entries expire after ttl unless set with their own TTL, the reaper
+// runs at the same interval. A ttl of zero or less keeps entries until
+// they are deleted or evicted. Close, or the context of WithContext,
+// stops the reaper
+func New[K comparable, V any](ttl time.Duration, opts ...Option[K, V]) *Cache[K, V] {
+	cache := &Cache[K, V]{
+		ttl:     ttl,
+		ctx:     context.Background(),
+		items:   make(map[K]*list.Element),
+		order:   list.New(),
+		pending: make(map[K]uint64),
+		done:    make(chan struct{}),
+	}
+
+	for _, opt := range opts {
+		opt(cache)
 	}
 
-	go cache.reapLoop()
+	if ttl > 0 || cache.ctx.Done() != nil {
+		go cache.reapLoop(cache.ctx)
+	}
 
 	return cache
 }
 
-type Cache struct {
-	interval time.Duration
-	data     map[string]cacheEntry
-	lock     *sync.Mutex
+// Must not be copied after first use, share the *Cache returned by New
+type Cache[K comparable, V any] struct {
+	ttl        time.Duration
+	maxEntries int
+	maxBytes   int
+	sizeFunc   func(V) int
+	onEvict    func(K, V, EvictionReason)
+	disk       *DiskStore
+	diskTTL    time.Duration
+	ctx        context.Context
+
+	//Held around each disk write, taken before lock
+	diskLock sync.Mutex
+
+	lock sync.Mutex
+	//Entries by recency, the front of order is the most recently used
+	items map[K]*list.Element
+	order *list.List
+	bytes int
+	//Bumped by every Set, Delete and Clear, so disk reads and writes can
+	//tell whether a newer change overtook them
+	gen uint64
+	//Generation of the latest change per key with a disk write to come
+	pending map[K]uint64
+	closed  bool
+	done    chan struct{}
+}
+
+type cacheEntry[K comparable, V any] struct {
+	key  K
+	val  V
+	size int
+	//Zero when the entry never expires
+	expiresAt time.Time
+}
+
+type eviction[K comparable, V any] struct {
+	key    K
+	val    V
+	reason EvictionReason
+}
+
+func (e *cacheEntry[K, V]) expired(now time.Time) bool {
+	return expired(e.expiresAt, now)
+}
+
+// Same as Set without the error, kept for existing callers
+func (c *Cache[K, V]) Add(key K, val V) {
+	c.Set(key, val)
 }
 
-type cacheEntry struct {
-	createdAt time.Time
-	val       []byte
+// Stores val with the default TTL, replacing any entry for key.
+// Returns ErrClosed after Close
+func (c *Cache[K, V]) Set(key K, val V) error {
+	return c.SetWithTTL(key, val, c.ttl)
 }
 
-func (c *Cache) Add(key string, val []byte) {
+// Also writes val to the disk tier of WithDisk. Returns ErrClosed after Close
+func (c *Cache[K, V]) SetWithTTL(key K, val V, ttl time.Duration) error {
+	expiresAt := time.Time{}
+
+	if ttl > 0 {
+		expiresAt = time.Now().Add(ttl)
+	}
+
+	gen, ok := c.store(key, val, expiresAt)
+
+	if !ok {
+		return ErrClosed
+	}
+
+	if c.disk != nil {
+		c.setOnDisk(key, val, expiresAt, gen)
+	}
+
+	return nil
+}
+
+// Adds the entry to memory and returns the generation of the change,
+// false when the cache is closed
+func (c *Cache[K, V]) store(key K, val V, expiresAt time.Time) (uint64, bool) {
+	entry := c.newEntry(key, val, expiresAt)
+
 	c.lock.Lock()
-	defer c.lock.Unlock()
-	_, ok := c.data[key]
+
+	if c.closed {
+		c.lock.Unlock()
+		return 0, false
+	}
+
+	gen := c.change(key)
+	evicted := c.insert(entry)
+	c.lock.Unlock()
+
+	c.notify(evicted)
+
+	return gen, true
+}
+
+// Same as store unless the cache changed since gen, when the disk was
+// read. A live entry set meanwhile wins and is returned instead, and
+// after a Delete or Clear val is returned without keeping it, so a
+// deleted value doesn't come back
+func (c *Cache[K, V]) storeIfAbsent(key K, val V, expiresAt time.Time, gen uint64) (V, bool) {
+	entry := c.newEntry(key, val, expiresAt)
+
+	c.lock.Lock()
+
+	if c.closed {
+		c.lock.Unlock()
+
+		var zero V
+		return zero, false
+	}
+
+	element, ok := c.items[key]
+
+	if ok && !element.Value.(*cacheEntry[K, V]).expired(time.Now()) {
+		c.order.MoveToFront(element)
+		c.lock.Unlock()
+
+		return element.Value.(*cacheEntry[K, V]).val, true
+	}
+
+	if c.gen != gen {
+		c.lock.Unlock()
+		return val, true
+	}
+
+	evicted := c.insert(entry)
+	c.lock.Unlock()
+
+	c.notify(evicted)
+
+	return val, true
+}
+
+func (c *Cache[K, V]) newEntry(key K, val V, expiresAt time.Time) *cacheEntry[K, V] {
+	entry := &cacheEntry[K, V]{key: key, val: val, expiresAt: expiresAt}
+
+	if c.sizeFunc != nil {
+		entry.size = c.sizeFunc(val)
+	}
+
+	return entry
+}
+
+// Bumps the generation for a change of key and, with a disk tier, marks
+// its disk write as the one to come. Must be called with the lock held
+func (c *Cache[K, V]) change(key K) uint64 {
+	c.gen++
+
+	if c.disk != nil {
+		c.pending[key] = c.gen
+	}
+
+	return c.gen
+}
+
+// Runs write unless a newer change of key came after gen, that change
+// writes to disk itself. Writes run one at a time, so they reach the
+// disk in the order of their changes
+func (c *Cache[K, V]) writeToDisk(key K, gen uint64, write func()) {
+	c.diskLock.Lock()
+	defer c.diskLock.Unlock()
+
+	c.lock.Lock()
+	latest := c.pending[key] == gen
+	c.lock.Unlock()
+
+	if !latest {
+		return
+	}
+
+	write()
+
+	c.lock.Lock()
+
+	if c.pending[key] == gen {
+		delete(c.pending, key)
+	}
+
+	c.lock.Unlock()
+}
+
+// Replaces any entry for the key and evicts over capacity.
+// Must be called with the lock held
+func (c *Cache[K, V]) insert(entry *cacheEntry[K, V]) []eviction[K, V] {
+	element, ok := c.items[entry.key]
+
+	if ok {
+		c.bytes -= element.Value.(*cacheEntry[K, V]).size
+		element.Value = entry
+		c.order.MoveToFront(element)
+	} else {
+		c.items[entry.key] = c.order.PushFront(entry)
+	}
+
+	c.bytes += entry.size
+
+	return c.evictOverCapacity()
+}
+
+// A miss, including an expired entry, returns the zero value of V.
+// Misses in memory read through to the disk tier of WithDisk.
+// After Close every call misses, Closed tells that apart from an empty cache
+func (c *Cache[K, V]) Get(key K) (V, bool) {
+	c.lock.Lock()
+
+	if c.closed {
+		c.lock.Unlock()
+
+		var zero V
+		return zero, false
+	}
+
+	element, ok := c.items[key]
 
 	if !ok {
-		c.data[key] = cacheEntry{
-			createdAt: time.Now(),
-			val:       val,
+		gen := c.gen
+		c.lock.Unlock()
+
+		return c.getFromDisk(key, gen)
+	}
+
+	entry := element.Value.(*cacheEntry[K, V])
+
+	if entry.expired(time.Now()) {
+		c.remove(element)
+		gen := c.gen
+		c.lock.Unlock()
+
+		c.notify([]eviction[K, V]{{entry.key, entry.val, EvictionExpired}})
+		return c.getFromDisk(key, gen)
+	}
+
+	c.order.MoveToFront(element)
+	c.lock.Unlock()
+
+	return entry.val, true
+}
+
+// Returns ErrClosed after Close
+func (c *Cache[K, V]) Delete(key K) error {
+	c.lock.Lock()
+
+	if c.closed {
+		c.lock.Unlock()
+		return ErrClosed
+	}
+
+	gen := c.change(key)
+	element, ok := c.items[key]
+	var entry *cacheEntry[K, V]
+
+	if ok {
+		entry = c.remove(element)
+	}
+
+	c.lock.Unlock()
+
+	if c.disk != nil {
+		c.writeToDisk(key, gen, func() {
+			c.disk.Delete(diskKey(key))
+		})
+	}
+
+	if ok {
+		c.notify([]eviction[K, V]{{entry.key, entry.val, EvictionManual}})
+	}
+
+	return nil
+}
+
+// Returns ErrClosed after Close
+func (c *Cache[K, V]) Clear() error {
+	//Held from the start, so a Set that follows can't write to disk
+	//before it is cleared
+	c.diskLock.Lock()
+	defer c.diskLock.Unlock()
+
+	c.lock.Lock()
+
+	if c.closed {
+		c.lock.Unlock()
+		return ErrClosed
+	}
+
+	c.gen++
+	clear(c.pending)
+	evicted := c.removeAll()
+	c.lock.Unlock()
+
+	if c.disk != nil {
+		c.disk.Clear()
+	}
+
+	c.notify(evicted)
+
+	return nil
+}
+
+// Reports whether Close was called, by the caller or by the context of
+// WithContext
+func (c *Cache[K, V]) Closed() bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.closed
+}
+
+// Stops the reaper and drops every entry without notifying WithOnEvict.
+// Afterwards Get misses, Len reports 0 and the calls that change the
+// cache return ErrClosed. Closing again is a no-op
+func (c *Cache[K, V]) Close() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.closed {
+		return
+	}
+
+	c.closed = true
+	close(c.done)
+	c.removeAll()
+}
+
+// Must be called with the lock held
+func (c *Cache[K, V]) removeAll() []eviction[K, V] {
+	evicted := make([]eviction[K, V], 0, c.order.Len())
+
+	for element := c.order.Front(); element != nil; element = element.Next() {
+		entry := element.Value.(*cacheEntry[K, V])
+		evicted = append(evicted, eviction[K, V]{entry.key, entry.val, EvictionManual})
+	}
+
+	clear(c.items)
+	c.order.Init()
+	c.bytes = 0
+
+	return evicted
+}
+
+// Number of entries that have not expired
+func (c *Cache[K, V]) Len() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	now := time.Now()
+	count := 0
+
+	for element := c.order.Front(); element != nil; element = element.Next() {
+		if !element.Value.(*cacheEntry[K, V]).expired(now) {
+			count++
 		}
 	}
+
+	return count
 }
 
-func (c *Cache) Get(key string) ([]byte, bool) {
+// Total size of the entries as measured by WithMaxBytes
+func (c *Cache[K, V]) Bytes() int {
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
-	entry, ok := c.data[key]
+	return c.bytes
+}
 
-	if ok {
-		return entry.val, ok
+// Must be called with the lock held
+func (c *Cache[K, V]) remove(element *list.Element) *cacheEntry[K, V] {
+	entry := c.order.Remove(element).(*cacheEntry[K, V])
+	delete(c.items, entry.key)
+	c.bytes -= entry.size
+
+	return entry
+}
+
+// Drops least recently used entries until the limits hold.
+// Must be called with the lock held
+func (c *Cache[K, V]) evictOverCapacity() []eviction[K, V] {
+	var evicted []eviction[K, V]
+	now := time.Now()
+
+	for c.overCapacity() {
+		entry := c.remove(c.order.Back())
+		reason := EvictionCapacity
+
+		if entry.expired(now) {
+			reason = EvictionExpired
+		}
+
+		evicted = append(evicted, eviction[K, V]{entry.key, entry.val, reason})
+	}
+
+	return evicted
+}
+
+func (c *Cache[K, V]) overCapacity() bool {
+	if c.order.Len() == 0 {
+		return false
+	}
+
+	return (c.maxEntries > 0 && c.order.Len() > c.maxEntries) || (c.maxBytes > 0 && c.bytes > c.maxBytes)
+}
+
+func (c *Cache[K, V]) notify(evicted []eviction[K, V]) {
+	if c.onEvict == nil {
+		return
 	}
 
-	return make([]byte, 0), ok
+	for _, e := range evicted {
+		c.onEvict(e.key, e.val, e.reason)
+	}
 }
 
-func (c *Cache) reapLoop() {
-	ticker := time.NewTicker(c.interval)
-	defer ticker.Stop()
-	for tick := range ticker.C {
+func (c *Cache[K, V]) reapLoop(ctx context.Context) {
+	//A nil channel never fires, so without a TTL this only waits for shutdown
+	var tick <-chan time.Time
+
+	if c.ttl > 0 {
+		ticker := time.NewTicker(c.ttl)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+
+	for {
+		var now time.Time
+
+		select {
+		case <-c.done:
+			return
+		case <-ctx.Done():
+			c.Close()
+			return
+		case now = <-tick:
+		}
+
+		var evicted []eviction[K, V]
+
 		c.lock.Lock()
-		for key, entry := range c.data {
-			if entry.createdAt.Compare(tick) < 0 {
-				delete(c.data, key)
+		for element := c.order.Front(); element != nil; {
+			next := element.Next()
+			entry := element.Value.(*cacheEntry[K, V])
+
+			if entry.expired(now) {
+				c.remove(element)
+				evicted = append(evicted, eviction[K, V]{entry.key, entry.val, EvictionExpired})
 			}
+
+			element = next
 		}
 		c.lock.Unlock()
+
+		c.notify(evicted)
 	}
 }
+
+// Disk entries keep the expiry of the entry unless WithDisk set a TTL of its own
+func (c *Cache[K, V]) setOnDisk(key K, val V, expiresAt time.Time, gen uint64) {
+	data, err := json.Marshal(val)
+
+	if err != nil {
+		return
+	}
+
+	if c.diskTTL > 0 {
+		expiresAt = time.Now().Add(c.diskTTL)
+	}
+
+	c.writeToDisk(key, gen, func() {
+		c.disk.Set(diskKey(key), data, expiresAt)
+	})
+}
+
+// Entries read from disk stay in memory for the default TTL at most,
+// gen is the generation of the cache when memory missed
+func (c *Cache[K, V]) getFromDisk(key K, gen uint64) (V, bool) {
+	var val V
+
+	if c.disk == nil {
+		return val, false
+	}
+
+	data, diskExpiresAt, ok := c.disk.Get(diskKey(key))
+
+	if !ok {
+		return val, false
+	}
+
+	err := json.Unmarshal(data, &val)
+
+	//Values written by an older shape of V are dropped
+	if err != nil {
+		c.dropFromDisk(key, gen)
+
+		var zero V
+		return zero, false
+	}
+
+	expiresAt := time.Time{}
+
+	if c.ttl > 0 {
+		expiresAt = time.Now().Add(c.ttl)
+	}
+
+	if !diskExpiresAt.IsZero() && (expiresAt.IsZero() || diskExpiresAt.Before(expiresAt)) {
+		expiresAt = diskExpiresAt
+	}
+
+	return c.storeIfAbsent(key, val, expiresAt, gen)
+}
+
+// Deletes an unreadable disk entry unless a change since gen may have
+// replaced it already
+func (c *Cache[K, V]) dropFromDisk(key K, gen uint64) {
+	c.diskLock.Lock()
+	defer c.diskLock.Unlock()
+
+	c.lock.Lock()
+	unchanged := c.gen == gen
+	c.lock.Unlock()
+
+	if unchanged {
+		c.disk.Delete(diskKey(key))
+	}
+}
+
+func diskKey[K comparable](key K) string {
+	return fmt.Sprint(key)
+}
@@ -1,6 +1,8 @@
 package pokecache
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"testing"
 	"time"
@@ -25,6 +27,7 @@ func TestAddGet(t *testing.T) {
 	for i, c := range cases {
 		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
 			cache := NewCache(interval)
+			defer cache.Close()
 			cache.Add(c.key, c.val)
 			val, ok := cache.Get(c.key)
 			if !ok {
@@ -43,6 +46,7 @@ func TestReapLoop(t *testing.T) {
 	const baseTime = 5 * time.Millisecond
 	const waitTime = baseTime + 5*time.Millisecond
 	cache := NewCache(baseTime)
+	defer cache.Close()
 	cache.Add("https://example.com", []byte("testdata"))
 
 	_, ok := cache.Get("https://example.com")
@@ -59,3 +63,239 @@ func TestReapLoop(t *testing.T) {
 		return
 	}
 }
+
+func TestTypedCache(t *testing.T) {
+	type pokemon struct {
+		Name  string
+		Types []string
+	}
+
+	cache := New[int, pokemon](5 * time.Second)
+	defer cache.Close()
+	cache.Add(25, pokemon{Name: "pikachu", Types: []string{"electric"}})
+
+	val, ok := cache.Get(25)
+	if !ok || val.Name != "pikachu" || len(val.Types) != 1 {
+		t.Errorf("expected pikachu, got %+v", val)
+	}
+
+	val, ok = cache.Get(133)
+	if ok || val.Name != "" {
+		t.Errorf("expected a miss with the zero value, got %+v", val)
+	}
+}
+
+func TestByteCacheMiss(t *testing.T) {
+	cache := NewCache(5 * time.Second)
+	defer cache.Close()
+
+	val, ok := cache.Get("https://example.com")
+	if ok || val == nil || len(val) != 0 {
+		t.Errorf("expected a miss with an empty slice, got %v %v", val, ok)
+	}
+}
+
+func TestSetOverwrites(t *testing.T) {
+	cache := New[string, string](5 * time.Second)
+	defer cache.Close()
+	cache.Add("pikachu", "electric")
+	cache.Set("pikachu", "mouse")
+
+	val, ok := cache.Get("pikachu")
+	if !ok || val != "mouse" {
+		t.Errorf("expected the overwritten value, got %q", val)
+	}
+}
+
+func TestSetWithTTL(t *testing.T) {
+	cache := New[string, int](time.Hour)
+	defer cache.Close()
+	cache.SetWithTTL("short", 1, 5*time.Millisecond)
+	cache.SetWithTTL("forever", 2, 0)
+	cache.Set("default", 3)
+
+	time.Sleep(10 * time.Millisecond)
+
+	_, ok := cache.Get("short")
+	if ok {
+		t.Errorf("expected the expired entry to miss before the reaper runs")
+	}
+
+	if cache.Len() != 2 {
+		t.Errorf("expected 2 live entries, got %d", cache.Len())
+	}
+}
+
+func TestReapLoopKeepsEntriesUntilTheirExpiry(t *testing.T) {
+	cache := New[string, int](5 * time.Millisecond)
+	defer cache.Close()
+	cache.SetWithTTL("long", 1, time.Hour)
+
+	time.Sleep(20 * time.Millisecond)
+
+	_, ok := cache.Get("long")
+	if !ok {
+		t.Errorf("expected the entry to survive reaps before its expiry")
+	}
+}
+
+func TestDeleteClearLen(t *testing.T) {
+	cache := New[string, int](5 * time.Second)
+	defer cache.Close()
+	cache.Set("a", 1)
+	cache.Set("b", 2)
+	cache.Set("c", 3)
+
+	cache.Delete("a")
+
+	_, ok := cache.Get("a")
+	if ok || cache.Len() != 2 {
+		t.Errorf("expected a to be deleted leaving 2 entries, got %d", cache.Len())
+	}
+
+	cache.Clear()
+
+	if cache.Len() != 0 {
+		t.Errorf("expected an empty cache, got %d entries", cache.Len())
+	}
+}
+
+func TestMaxEntriesEvictsLeastRecentlyUsed(t *testing.T) {
+	evicted := map[string]EvictionReason{}
+	cache := New[string, int](time.Hour, WithMaxEntries[string, int](2), WithOnEvict(func(key string, val int, reason EvictionReason) {
+		evicted[key] = reason
+	}))
+	defer cache.Close()
+
+	cache.Set("bulbasaur", 1)
+	cache.Set("charmander", 4)
+	cache.Get("bulbasaur")
+	cache.Set("squirtle", 7)
+
+	_, ok := cache.Get("charmander")
+	if ok {
+		t.Errorf("expected the least recently used entry to be evicted")
+	}
+
+	_, ok = cache.Get("bulbasaur")
+	if !ok {
+		t.Errorf("expected the recently read entry to stay")
+	}
+
+	if len(evicted) != 1 || evicted["charmander"] != EvictionCapacity {
+		t.Errorf("expected one capacity eviction, got %v", evicted)
+	}
+}
+
+func TestMaxBytes(t *testing.T) {
+	cache := NewCache(time.Hour, WithMaxValueBytes(10))
+	defer cache.Close()
+
+	cache.Set("a", []byte("1234"))
+	cache.Set("b", []byte("5678"))
+	cache.Set("c", []byte("90"))
+
+	if cache.Bytes() != 10 || cache.Len() != 3 {
+		t.Errorf("expected 3 entries in 10 bytes, got %d in %d", cache.Len(), cache.Bytes())
+	}
+
+	cache.Set("b", []byte("56789"))
+
+	_, ok := cache.Get("a")
+	if ok || cache.Bytes() != 7 {
+		t.Errorf("expected a to be evicted leaving 7 bytes, got %d", cache.Bytes())
+	}
+
+	cache.Set("big", []byte("this value is too big"))
+
+	if cache.Len() != 0 || cache.Bytes() != 0 {
+		t.Errorf("expected everything to be evicted, got %d entries in %d bytes", cache.Len(), cache.Bytes())
+	}
+}
+
+func TestEvictionReasons(t *testing.T) {
+	reasons := make(chan EvictionReason, 10)
+	cache := New[string, int](time.Hour, WithOnEvict(func(key string, val int, reason EvictionReason) {
+		reasons <- reason
+	}))
+	defer cache.Close()
+
+	cache.SetWithTTL("expiring", 1, time.Millisecond)
+	time.Sleep(5 * time.Millisecond)
+	cache.Get("expiring")
+
+	cache.Set("deleted", 2)
+	cache.Delete("deleted")
+
+	cache.Set("cleared", 3)
+	cache.Clear()
+
+	want := []EvictionReason{EvictionExpired, EvictionManual, EvictionManual}
+
+	for _, w := range want {
+		got := <-reasons
+
+		if got != w {
+			t.Errorf("expected %s eviction, got %s", w, got)
+		}
+	}
+}
+
+func TestClose(t *testing.T) {
+	evicted := 0
+	cache := New[string, int](time.Millisecond, WithOnEvict(func(key string, val int, reason EvictionReason) {
+		evicted++
+	}))
+	cache.Set("pikachu", 25)
+
+	if cache.Closed() {
+		t.Errorf("expected an open cache before Close")
+	}
+
+	cache.Close()
+	cache.Close()
+
+	if !cache.Closed() {
+		t.Errorf("expected Closed after Close")
+	}
+
+	for name, err := range map[string]error{
+		"Set":        cache.Set("eevee", 133),
+		"SetWithTTL": cache.SetWithTTL("eevee", 133, time.Hour),
+		"Delete":     cache.Delete("eevee"),
+		"Clear":      cache.Clear(),
+	} {
+		if !errors.Is(err, ErrClosed) {
+			t.Errorf("expected ErrClosed from %s, got %v", name, err)
+		}
+	}
+
+	_, ok := cache.Get("pikachu")
+	if ok || cache.Len() != 0 || cache.Bytes() != 0 {
+		t.Errorf("expected a closed cache to be empty, got %d entries", cache.Len())
+	}
+
+	if evicted != 0 {
+		t.Errorf("expected no evictions to be reported after close, got %d", evicted)
+	}
+}
+
+func TestContextClosesCache(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cache := New[string, int](0, WithContext[string, int](ctx))
+	defer cache.Close()
+
+	cancel()
+
+	deadline := time.Now().Add(time.Second)
+
+	for time.Now().Before(deadline) {
+		if cache.Closed() {
+			return
+		}
+
+		time.Sleep(time.Millisecond)
+	}
+
+	t.Errorf("expected the cache to close once its context was cancelled")
+}
@@ -0,0 +1,3 @@
+module github.com/tenmoses/pokecatch
+
+go 1.22.0
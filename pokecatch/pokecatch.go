@@ -0,0 +1,113 @@
+package pokecatch
+
+import (
+	"math"
+	"math/rand/v2"
+)
+
+const (
+	StatusNone      = ""
+	StatusSleep     = "sleep"
+	StatusFreeze    = "freeze"
+	StatusParalysis = "paralysis"
+	StatusPoison    = "poison"
+	StatusBurn      = "burn"
+)
+
+// Gen III/IV catch rate multipliers of the balls
+var BallBonuses = map[string]float64{
+	"poke-ball":    1,
+	"premier-ball": 1,
+	"great-ball":   1.5,
+	"safari-ball":  1.5,
+	"ultra-ball":   2,
+	"master-ball":  255,
+}
+
+var statusBonuses = map[string]float64{
+	StatusNone:      1,
+	StatusSleep:     2,
+	StatusFreeze:    2,
+	StatusParalysis: 1.5,
+	StatusPoison:    1.5,
+	StatusBurn:      1.5,
+}
+
+type Attempt struct {
+	CaptureRate int
+	BallBonus   float64
+	Status      string
+	CurrentHP   int
+	MaxHP       int
+}
+
+type Result struct {
+	Caught bool
+	//Shake checks passed, all four of them means the Pokemon was caught
+	Shakes      int
+	Probability float64
+}
+
+// Modified catch rate "a" of the Gen III/IV formula
+func (a Attempt) catchValue() float64 {
+	maxHP, currentHP := float64(a.MaxHP), float64(a.CurrentHP)
+
+	//Unknown HP counts as a full health Pokemon
+	if maxHP <= 0 {
+		maxHP, currentHP = 1, 1
+	}
+
+	currentHP = math.Max(1, math.Min(currentHP, maxHP))
+
+	ballBonus := a.BallBonus
+	if ballBonus <= 0 {
+		ballBonus = 1
+	}
+
+	statusBonus, ok := statusBonuses[a.Status]
+	if !ok {
+		statusBonus = 1
+	}
+
+	value := math.Floor((3*maxHP - 2*currentHP) * float64(a.CaptureRate) * ballBonus / (3 * maxHP))
+
+	return value * statusBonus
+}
+
+// Chance to pass one shake check out of 65536, 65536 is a certain catch
+func (a Attempt) shakeThreshold() float64 {
+	value := a.catchValue()
+
+	if value >= 255 {
+		return 65536
+	}
+
+	if value <= 0 {
+		return 0
+	}
+
+	return math.Floor(1048560 / math.Sqrt(math.Sqrt(16711680/value)))
+}
+
+func Probability(attempt Attempt) float64 {
+	return math.Pow(attempt.shakeThreshold()/65536, 4)
+}
+
+func Throw(attempt Attempt, rng *rand.Rand) Result {
+	threshold := attempt.shakeThreshold()
+	result := Result{
+		Probability: math.Pow(threshold/65536, 4),
+	}
+
+	for result.Shakes < 4 {
+		if float64(rng.IntN(65536)) >= threshold {
+			return result
+		}
+
+		result.Shakes++
+	}
+
+	result.Caught = true
+
+	return result
+}
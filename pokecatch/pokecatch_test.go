@@ -0,0 +1,80 @@
+package pokecatch
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestProbability(t *testing.T) {
+	cases := []struct {
+		name    string
+		attempt Attempt
+		want    float64
+	}{
+		{"master ball", Attempt{CaptureRate: 3, BallBonus: BallBonuses["master-ball"], MaxHP: 100, CurrentHP: 100}, 1},
+		{"common full hp", Attempt{CaptureRate: 255, BallBonus: 1, MaxHP: 35, CurrentHP: 35}, 0.3328},
+		{"common one hp asleep", Attempt{CaptureRate: 255, BallBonus: 1, Status: StatusSleep, MaxHP: 35, CurrentHP: 1}, 1},
+		{"legendary ultra ball", Attempt{CaptureRate: 3, BallBonus: 2, MaxHP: 106, CurrentHP: 106}, 0.0078},
+		{"uncatchable", Attempt{CaptureRate: 0, BallBonus: 2, MaxHP: 10, CurrentHP: 10}, 0},
+	}
+
+	for _, c := range cases {
+		got := Probability(c.attempt)
+
+		if math.Abs(got-c.want) > 0.001 {
+			t.Errorf("%s: expected probability %.4f, got %.4f", c.name, c.want, got)
+		}
+	}
+}
+
+func TestLowerHPRaisesProbability(t *testing.T) {
+	full := Probability(Attempt{CaptureRate: 45, BallBonus: 1, MaxHP: 45, CurrentHP: 45})
+	low := Probability(Attempt{CaptureRate: 45, BallBonus: 1, MaxHP: 45, CurrentHP: 5})
+
+	if low <= full {
+		t.Errorf("expected low hp probability %.4f to exceed full hp probability %.4f", low, full)
+	}
+}
+
+func TestThrow(t *testing.T) {
+	attempt := Attempt{CaptureRate: 45, BallBonus: 1.5, MaxHP: 45, CurrentHP: 45}
+	rng := rand.New(rand.NewPCG(1, 2))
+
+	const throws = 20000
+	caught := 0
+
+	for i := 0; i < throws; i++ {
+		throw := Throw(attempt, rng)
+
+		if throw.Caught != (throw.Shakes == 4) {
+			t.Fatalf("caught %v with %d shakes", throw.Caught, throw.Shakes)
+		}
+
+		if throw.Caught {
+			caught++
+		}
+	}
+
+	want := Probability(attempt)
+	got := float64(caught) / throws
+
+	if math.Abs(got-want) > 0.02 {
+		t.Errorf("expected catch rate near %.3f, got %.3f", want, got)
+	}
+}
+
+func TestThrowIsDeterministicForSeed(t *testing.T) {
+	attempt := Attempt{CaptureRate: 120, BallBonus: 1, MaxHP: 60, CurrentHP: 30}
+
+	first := rand.New(rand.NewPCG(42, 42))
+	second := rand.New(rand.NewPCG(42, 42))
+
+	for i := 0; i < 100; i++ {
+		a, b := Throw(attempt, first), Throw(attempt, second)
+
+		if a != b {
+			t.Fatalf("throw %d differs: %+v and %+v", i, a, b)
+		}
+	}
+}
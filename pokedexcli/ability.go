@@ -0,0 +1,59 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/tenmoses/pokeapi"
+)
+
+func commandAbility(ctx context.Context, name string, client *pokeapi.Client, cache *caches) error {
+	ability, err := getAbility(ctx, name, client, cache)
+
+	if errors.Is(err, pokeapi.ErrNotFound) {
+		fmt.Printf("No ability named %s\n", name)
+		return nil
+	}
+
+	if err != nil {
+		printError(err)
+		return nil
+	}
+
+	fmt.Printf("Name: %s\n", ability.Name)
+
+	shortEffect := ability.ShortEffect("en")
+
+	if shortEffect != "" {
+		fmt.Printf("Short effect: %s\n", shortEffect)
+	}
+
+	effect := ability.Effect("en")
+
+	if effect != "" && effect != shortEffect {
+		fmt.Printf("Effect: %s\n", effect)
+	}
+
+	if len(ability.Pokemon) > 0 {
+		fmt.Print("Pokemon:\n")
+
+		for _, pokemon := range ability.Pokemon {
+			if pokemon.IsHidden {
+				fmt.Printf("- %s (hidden)\n", pokemon.Pokemon.Name)
+			} else {
+				fmt.Printf("- %s\n", pokemon.Pokemon.Name)
+			}
+		}
+	}
+
+	return nil
+}
+
+func getAbility(ctx context.Context, name string, client *pokeapi.Client, cache *caches) (pokeapi.Ability, error) {
+	cacheKey := fmt.Sprintf("ability_%s", name)
+
+	return getCached(cache.abilities, cacheKey, func() (pokeapi.Ability, error) {
+		return client.GetAbilityContext(ctx, name)
+	})
+}
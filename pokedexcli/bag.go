@@ -0,0 +1,110 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/tenmoses/pokeapi"
+	"github.com/tenmoses/pokecatch"
+)
+
+const defaultBall = "poke-ball"
+
+// Item counts by item name
+type bag map[string]int
+
+func newBag() bag {
+	return bag{
+		"poke-ball":   20,
+		"great-ball":  10,
+		"ultra-ball":  5,
+		"master-ball": 1,
+	}
+}
+
+func commandBag(items bag) error {
+	names := make([]string, 0, len(items))
+
+	for name, count := range items {
+		if count > 0 {
+			names = append(names, name)
+		}
+	}
+
+	if len(names) == 0 {
+		fmt.Println("Your bag is empty")
+		return nil
+	}
+
+	sort.Strings(names)
+
+	fmt.Println("Your bag:")
+
+	for _, name := range names {
+		fmt.Printf("- %s x%d\n", name, items[name])
+	}
+
+	return nil
+}
+
+func commandItem(ctx context.Context, name string, client *pokeapi.Client, cache *caches) error {
+	item, err := getItem(ctx, name, client, cache)
+
+	if errors.Is(err, pokeapi.ErrNotFound) {
+		fmt.Printf("No item named %s\n", name)
+		return nil
+	}
+
+	if err != nil {
+		printError(err)
+		return nil
+	}
+
+	fmt.Printf("Name: %s\n", item.Name)
+	fmt.Printf("Category: %s\n", item.Category.Name)
+	fmt.Printf("Cost: %d\n", item.Cost)
+
+	effect := item.ShortEffect("en")
+
+	if effect != "" {
+		fmt.Printf("Effect: %s\n", effect)
+	}
+
+	return nil
+}
+
+// Catch rate multiplier of the ball, PokeAPI only describes it in the effect text
+// so balls missing from pokecatch.BallBonuses count as a poke-ball
+func getBallBonus(ctx context.Context, ball string, client *pokeapi.Client, cache *caches) (float64, error) {
+	bonus, ok := pokecatch.BallBonuses[ball]
+
+	if ok {
+		return bonus, nil
+	}
+
+	item, err := getItem(ctx, ball, client, cache)
+
+	if errors.Is(err, pokeapi.ErrNotFound) {
+		return 0, fmt.Errorf("No item named %s", ball)
+	}
+
+	if err != nil {
+		return 0, err
+	}
+
+	if !item.IsPokeBall() {
+		return 0, fmt.Errorf("%s is not a Poké Ball", ball)
+	}
+
+	return pokecatch.BallBonuses[defaultBall], nil
+}
+
+func getItem(ctx context.Context, name string, client *pokeapi.Client, cache *caches) (pokeapi.Item, error) {
+	cacheKey := fmt.Sprintf("item_%s", name)
+
+	return getCached(cache.items, cacheKey, func() (pokeapi.Item, error) {
+		return client.GetItemContext(ctx, name)
+	})
+}
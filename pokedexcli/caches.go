@@ -0,0 +1,136 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/tenmoses/pokeapi"
+	"github.com/tenmoses/pokecache"
+)
+
+const (
+	//Bounds each cache so prefetching many resources can't exhaust memory
+	maxCachedEntries = 500
+	//PokeAPI data rarely changes, so lookups are reused for a day
+	diskCacheTTL      = 24 * time.Hour
+	maxDiskCacheBytes = 8 << 20
+)
+
+// One typed cache per kind of PokeAPI lookup
+type caches struct {
+	pokemon         *pokecache.Cache[string, pokeapi.PokemonToCatch]
+	pages           *pokecache.Cache[string, pokeapi.LocationAreaPage]
+	encounters      *pokecache.Cache[string, []pokeapi.AreaEncounter]
+	evolutionChains *pokecache.Cache[string, pokeapi.EvolutionChain]
+	types           *pokecache.Cache[string, pokeapi.Type]
+	learnsets       *pokecache.Cache[string, []pokeapi.LearnsetEntry]
+	moves           *pokecache.Cache[string, pokeapi.Move]
+	abilities       *pokecache.Cache[string, pokeapi.Ability]
+	items           *pokecache.Cache[string, pokeapi.Item]
+	regions         *pokecache.Cache[string, pokeapi.Region]
+	locations       *pokecache.Cache[string, pokeapi.Location]
+}
+
+// A dir keeps lookups on disk across sessions, one subdirectory per cache
+func newCaches(interval time.Duration, dir string) *caches {
+	return &caches{
+		pokemon:         newCache[pokeapi.PokemonToCatch](interval, dir, "pokemon"),
+		pages:           newCache[pokeapi.LocationAreaPage](interval, dir, "pages"),
+		encounters:      newCache[[]pokeapi.AreaEncounter](interval, dir, "encounters"),
+		evolutionChains: newCache[pokeapi.EvolutionChain](interval, dir, "evolution-chains"),
+		types:           newCache[pokeapi.Type](interval, dir, "types"),
+		learnsets:       newCache[[]pokeapi.LearnsetEntry](interval, dir, "learnsets"),
+		moves:           newCache[pokeapi.Move](interval, dir, "moves"),
+		abilities:       newCache[pokeapi.Ability](interval, dir, "abilities"),
+		items:           newCache[pokeapi.Item](interval, dir, "items"),
+		regions:         newCache[pokeapi.Region](interval, dir, "regions"),
+		locations:       newCache[pokeapi.Location](interval, dir, "locations"),
+	}
+}
+
+func newCache[V any](interval time.Duration, dir string, name string) *pokecache.Cache[string, V] {
+	options := []pokecache.Option[string, V]{pokecache.WithMaxEntries[string, V](maxCachedEntries)}
+
+	if dir != "" {
+		store, err := pokecache.OpenDiskStore(filepath.Join(dir, name), maxDiskCacheBytes)
+
+		if err != nil {
+			fmt.Printf("Disk cache for %s disabled: %v\n", name, err)
+		} else {
+			options = append(options, pokecache.WithDisk[string, V](store, diskCacheTTL))
+		}
+	}
+
+	return pokecache.New[string, V](interval, options...)
+}
+
+func defaultCacheDir() string {
+	dir, err := os.UserCacheDir()
+
+	if err != nil {
+		return ""
+	}
+
+	return filepath.Join(dir, "pokedex")
+}
+
+// Lookups from different base URLs don't match, so each gets its own
+// subdirectory of dir, e.g. pokeapi.co_api_v2. An empty dir stays empty
+func baseURLCacheDir(dir string, baseURL string) string {
+	if dir == "" {
+		return ""
+	}
+
+	_, rest, ok := strings.Cut(baseURL, "://")
+
+	if !ok {
+		rest = baseURL
+	}
+
+	namespace := strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '.' || r == '-' {
+			return r
+		}
+
+		return '_'
+	}, strings.Trim(rest, "/"))
+
+	return filepath.Join(dir, namespace)
+}
+
+// Stops the reapers of every cache
+func (c *caches) Close() {
+	c.pokemon.Close()
+	c.pages.Close()
+	c.encounters.Close()
+	c.evolutionChains.Close()
+	c.types.Close()
+	c.learnsets.Close()
+	c.moves.Close()
+	c.abilities.Close()
+	c.items.Close()
+	c.regions.Close()
+	c.locations.Close()
+}
+
+// Fetch is only called on a miss, its errors are not cached
+func getCached[T any](cache *pokecache.Cache[string, T], key string, fetch func() (T, error)) (T, error) {
+	cached, ok := cache.Get(key)
+
+	if ok {
+		return cached, nil
+	}
+
+	value, err := fetch()
+
+	if err != nil {
+		return value, err
+	}
+
+	cache.Set(key, value)
+
+	return value, nil
+}
@@ -0,0 +1,29 @@
+package main
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestBaseURLCacheDir(t *testing.T) {
+	cases := []struct {
+		baseURL string
+		want    string
+	}{
+		{baseURL: "https://pokeapi.co/api/v2", want: "pokeapi.co_api_v2"},
+		{baseURL: "http://127.0.0.1:8765/", want: "127.0.0.1_8765"},
+		{baseURL: "mirror", want: "mirror"},
+	}
+
+	for _, c := range cases {
+		got := baseURLCacheDir("cache", c.baseURL)
+		if got != filepath.Join("cache", c.want) {
+			t.Errorf("baseURLCacheDir(%q) = %q, want %q", c.baseURL, got, filepath.Join("cache", c.want))
+		}
+	}
+
+	got := baseURLCacheDir("", "https://pokeapi.co/api/v2")
+	if got != "" {
+		t.Errorf("expected no cache dir without a dir, got %q", got)
+	}
+}
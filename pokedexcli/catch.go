@@ -0,0 +1,52 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/tenmoses/pokeapi"
+	"github.com/tenmoses/pokecatch"
+)
+
+func commandOdds(ctx context.Context, name string, ball string, client *pokeapi.Client, cache *caches) error {
+	if ball == "" {
+		ball = defaultBall
+	}
+
+	ballBonus, err := getBallBonus(ctx, ball, client, cache)
+
+	if err != nil {
+		printError(err)
+		return nil
+	}
+
+	pokemon, err := getPokemonWithSpecies(ctx, name, client, cache)
+
+	if errors.Is(err, pokeapi.ErrNotFound) {
+		fmt.Printf("No Pokémon named %s\n", name)
+		return nil
+	}
+
+	if err != nil {
+		printError(err)
+		return nil
+	}
+
+	probability := pokecatch.Probability(catchAttempt(pokemon, ballBonus))
+
+	fmt.Printf("Chance to catch %s with a %s: %.1f%%\n", name, ball, probability*100)
+
+	return nil
+}
+
+// Wild Pokemon are met at full health and without a status condition
+func catchAttempt(pokemon pokeapi.PokemonToCatch, ballBonus float64) pokecatch.Attempt {
+	return pokecatch.Attempt{
+		CaptureRate: pokemon.CaptureRate,
+		BallBonus:   ballBonus,
+		Status:      pokecatch.StatusNone,
+		CurrentHP:   pokemon.Stats["hp"],
+		MaxHP:       pokemon.Stats["hp"],
+	}
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/tenmoses/pokeapi"
+)
+
+func commandEvolutions(ctx context.Context, name string, client *pokeapi.Client, cache *caches) error {
+	chain, err := getEvolutionChain(ctx, name, client, cache)
+
+	if errors.Is(err, pokeapi.ErrNotFound) {
+		fmt.Printf("No Pokémon species named %s\n", name)
+		return nil
+	}
+
+	if err != nil {
+		printError(err)
+		return nil
+	}
+
+	predecessors := chain.Predecessors(name)
+
+	if len(predecessors) > 0 {
+		fmt.Printf("Evolves from: %s\n", strings.Join(predecessors, " -> "))
+	}
+
+	successors := chain.Successors(name)
+
+	if len(successors) > 0 {
+		names := make([]string, 0, len(successors))
+		for _, successor := range successors {
+			names = append(names, successor.Species.Name)
+		}
+		fmt.Printf("Evolves into: %s\n", strings.Join(names, ", "))
+	}
+
+	if len(predecessors) == 0 && len(successors) == 0 {
+		fmt.Printf("%s does not evolve\n", name)
+		return nil
+	}
+
+	fmt.Println()
+	fmt.Println(chain.Chain.Species.Name)
+	printEvolutions(chain.Chain.EvolvesTo, "")
+
+	return nil
+}
+
+func printEvolutions(links []pokeapi.ChainLink, indent string) {
+	for i, link := range links {
+		branch, nextIndent := "├─ ", indent+"│  "
+		if i == len(links)-1 {
+			branch, nextIndent = "└─ ", indent+"   "
+		}
+
+		conditions := make([]string, 0, len(link.EvolutionDetails))
+		for _, detail := range link.EvolutionDetails {
+			conditions = append(conditions, detail.String())
+		}
+
+		fmt.Printf("%s%s%s", indent, branch, link.Species.Name)
+		if len(conditions) > 0 {
+			fmt.Printf(" (%s)", strings.Join(conditions, " or "))
+		}
+		fmt.Println()
+
+		printEvolutions(link.EvolvesTo, nextIndent)
+	}
+}
+
+func getEvolutionChain(ctx context.Context, species string, client *pokeapi.Client, cache *caches) (pokeapi.EvolutionChain, error) {
+	cacheKey := fmt.Sprintf("evolutionChain_%s", species)
+
+	return getCached(cache.evolutionChains, cacheKey, func() (pokeapi.EvolutionChain, error) {
+		return client.GetEvolutionChainForSpeciesContext(ctx, species)
+	})
+}
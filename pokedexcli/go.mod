@@ -4,8 +4,10 @@ go 1.22.0
 
 replace github.com/tenmoses/pokeapi v0.0.0 => ../pokeapi
 replace github.com/tenmoses/pokecache v0.0.0 => ../pokecache
+replace github.com/tenmoses/pokecatch v0.0.0 => ../pokecatch
 
 require (
 	github.com/tenmoses/pokeapi v0.0.0
 	github.com/tenmoses/pokecache v0.0.0
+	github.com/tenmoses/pokecatch v0.0.0
 )
@@ -2,21 +2,48 @@ package main
 
 import (
 	"bufio"
-	"encoding/json"
+	"context"
 	"errors"
+	"flag"
 	"fmt"
-	"math/rand"
+	"log"
+	"math/rand/v2"
 	"os"
+	"os/signal"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/tenmoses/pokeapi"
-	"github.com/tenmoses/pokecache"
+	"github.com/tenmoses/pokecatch"
 )
 
 func main() {
+	baseURL := flag.String("base-url", pokeapi.DefaultBaseURL, "PokeAPI base URL, e.g. a self-hosted mirror")
+	timeout := flag.Duration("timeout", 10*time.Second, "timeout for a single PokeAPI request")
+	retries := flag.Int("retries", pokeapi.DefaultRetryPolicy.MaxAttempts, "maximum attempts for a failing PokeAPI request")
+	rate := flag.Float64("rate", 10, "maximum PokeAPI requests per second, 0 disables the limit")
+	burst := flag.Int("burst", 5, "number of PokeAPI requests allowed in a burst above the rate")
+	debug := flag.Bool("debug", false, "print debug output, such as retried requests, to stderr")
+	savePath := flag.String("save", "", "save file of the Pokedex, bag and map position, defaults to pokedex/save.json in the user config directory")
+	cacheDir := flag.String("cache-dir", defaultCacheDir(), "directory that keeps PokeAPI lookups between sessions, empty keeps them in memory only")
+	seed := flag.Uint64("seed", 0, "seed for catching and other random outcomes, the same seed and inputs replay a session")
+	flag.Parse()
+
 	fmt.Println("pokedex")
 
+	seedSet := false
+	flag.Visit(func(f *flag.Flag) {
+		if f.Name == "seed" {
+			seedSet = true
+		}
+	})
+
+	if !seedSet {
+		*seed = rand.Uint64()
+		fmt.Printf("Using seed %d\n", *seed)
+	}
+
 	readCh := make(chan string)
 	defer close(readCh)
 
@@ -24,52 +51,195 @@ func main() {
 
 	commands := getCommands()
 
+	rateLimiter := pokeapi.NewRateLimiter(*rate, *burst)
+	retryPolicy := pokeapi.DefaultRetryPolicy
+	retryPolicy.MaxAttempts = *retries
+
+	clientOptions := []pokeapi.Option{
+		pokeapi.WithBaseURL(*baseURL),
+		pokeapi.WithTimeout(*timeout),
+		pokeapi.WithRetryPolicy(retryPolicy),
+		pokeapi.WithRateLimiter(rateLimiter),
+	}
+
+	if *debug {
+		debugLogger := log.New(os.Stderr, "debug: ", log.LstdFlags)
+		clientOptions = append(clientOptions, pokeapi.WithDebugLogger(debugLogger))
+
+		defer func() {
+			debugLogger.Printf("requests throttled for %s in total", rateLimiter.Throttled())
+		}()
+	}
+
+	client := pokeapi.NewClient(clientOptions...)
+
 	conf := config{
-		Next:     0,
-		Previous: 0,
+		Next:     client.LocationAreaPageURL(20, 0),
+		Previous: "",
 	}
 
-	cache := pokecache.NewCache(6000 * time.Millisecond)
+	cache := newCaches(6000*time.Millisecond, baseURLCacheDir(*cacheDir, client.BaseURL()))
+	defer cache.Close()
 	pokedex := make(map[string]pokeapi.PokemonToCatch)
+	items := newBag()
+
+	if *savePath == "" {
+		path, err := defaultSavePath()
+
+		if err != nil {
+			fmt.Printf("Progress will not be saved: %v\n", err)
+		} else {
+			*savePath = path
+		}
+	}
+
+	if *savePath != "" {
+		save, ok, err := loadSave(*savePath)
+
+		if err != nil {
+			fmt.Printf("Could not load the save: %v\n", err)
+
+			if errors.Is(err, errSaveCorrupted) {
+				fmt.Println("Fix the file or pick another one with --save")
+			}
+
+			os.Exit(1)
+		}
+
+		if ok {
+			pokedex, items, conf = save.Pokedex, save.Bag, rebaseConfig(save.Config, client)
+			fmt.Printf("Loaded %d Pokémon from %s\n", len(pokedex), *savePath)
+		}
+	}
+	rng := newSeededRand(*seed)
 
 	for commandLine := range readCh {
 		commandName, args := parseCommand(commandLine)
 		command, ok := commands[commandName]
 
 		if ok {
-			switch command.callback {
-			case "commandHelp":
-				commandHelp()
-			case "commandMap":
-				commandMap(&conf, cache)
-			case "commandMapB":
-				commandMapB(&conf, cache)
-			case "commandExplore":
-				if len(args) > 0 {
-					commandExplore(args[0], cache)
-				} else {
-					fmt.Println("No location area name specified")
-				}
-			case "commandCatch":
-				if len(args) > 0 {
-					commandCatch(args[0], cache, pokedex)
-				} else {
-					fmt.Println("No location area name specified")
-				}
-			case "commandInspect":
-				if len(args) > 0 {
-					commandInspect(args[0], pokedex)
-				} else {
-					fmt.Println("No pokemon name specified")
-				}
-			case "commandPokedex":
-				commandPokedex(pokedex)
-			case "commandExit":
+			if command.callback == "commandExit" {
 				return
-			default:
-				fmt.Println("No callback function found")
 			}
+
+			//Ctrl-C cancels the running command and returns to the prompt
+			ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+			runCommand(ctx, command, args, &conf, client, cache, pokedex, items, rng)
+			stop()
+
+			if command.mutates && *savePath != "" {
+				err := writeSave(*savePath, saveState{Pokedex: pokedex, Bag: items, Config: conf})
+
+				if err != nil {
+					fmt.Printf("Could not save progress: %v\n", err)
+				}
+			}
+		}
+	}
+}
+
+func runCommand(ctx context.Context, command cliCommand, args []string, conf *config, client *pokeapi.Client, cache *caches, pokedex map[string]pokeapi.PokemonToCatch, items bag, rng *seededRand) {
+	switch command.callback {
+	case "commandHelp":
+		commandHelp()
+	case "commandMap":
+		_, flags := parseFlags(args)
+		commandMap(ctx, conf, flags["region"], flags["location"], client, cache)
+	case "commandMapB":
+		commandMapB(ctx, conf, client, cache)
+	case "commandExplore":
+		positional, flags := parseFlags(args)
+		if len(positional) > 0 {
+			filter := pokeapi.EncounterFilter{
+				Version: flags["version"],
+				Method:  flags["method"],
+			}
+			commandExplore(ctx, positional[0], filter, client, cache)
+		} else {
+			fmt.Println("No location area name specified")
+		}
+	case "commandCatch":
+		positional, flags := parseFlags(args)
+		if len(positional) > 0 {
+			commandCatch(ctx, positional[0], flags["ball"], client, cache, pokedex, items, rng.Rand)
+		} else {
+			fmt.Println("No pokemon name specified")
+		}
+	case "commandOdds":
+		positional, flags := parseFlags(args)
+		if len(positional) > 0 {
+			commandOdds(ctx, positional[0], flags["ball"], client, cache)
+		} else {
+			fmt.Println("No pokemon name specified")
+		}
+	case "commandSeed":
+		commandSeed(args, rng)
+	case "commandInspect":
+		if len(args) > 0 {
+			commandInspect(args[0], pokedex)
+		} else {
+			fmt.Println("No pokemon name specified")
+		}
+	case "commandPokedex":
+		commandPokedex(pokedex)
+	case "commandEvolutions":
+		if len(args) > 0 {
+			commandEvolutions(ctx, args[0], client, cache)
+		} else {
+			fmt.Println("No pokemon name specified")
+		}
+	case "commandMatchup":
+		if len(args) > 1 {
+			commandMatchup(ctx, args[0], args[1], client, cache)
+		} else {
+			fmt.Println("Usage: matchup <attack-type> <pokemon|type[/type]>")
+		}
+	case "commandWeaknesses":
+		if len(args) > 0 {
+			commandWeaknesses(ctx, args[0], client, cache)
+		} else {
+			fmt.Println("No pokemon name specified")
+		}
+	case "commandMoves":
+		positional, flags := parseFlags(args)
+		if len(positional) > 0 {
+			commandMoves(ctx, positional[0], flags["version-group"], client, cache)
+		} else {
+			fmt.Println("No pokemon name specified")
+		}
+	case "commandMove":
+		if len(args) > 0 {
+			commandMove(ctx, args[0], client, cache)
+		} else {
+			fmt.Println("No move name specified")
+		}
+	case "commandAbility":
+		if len(args) > 0 {
+			commandAbility(ctx, args[0], client, cache)
+		} else {
+			fmt.Println("No ability name specified")
+		}
+	case "commandWhere":
+		positional, flags := parseFlags(args)
+		if len(positional) > 0 {
+			filter := pokeapi.EncounterFilter{
+				Version: flags["version"],
+				Method:  flags["method"],
+			}
+			commandWhere(ctx, positional[0], filter, client, cache)
+		} else {
+			fmt.Println("No pokemon name specified")
+		}
+	case "commandBag":
+		commandBag(items)
+	case "commandItem":
+		if len(args) > 0 {
+			commandItem(ctx, args[0], client, cache)
+		} else {
+			fmt.Println("No item name specified")
 		}
+	default:
+		fmt.Println("No callback function found")
 	}
 }
 
@@ -95,10 +265,42 @@ func parseCommand(line string) (string, []string) {
 	return parts[0], parts[1:]
 }
 
+// Splits args into positional ones and --name value or --name=value flags
+func parseFlags(args []string) ([]string, map[string]string) {
+	positional := make([]string, 0)
+	flags := make(map[string]string)
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+
+		if arg == "" {
+			continue
+		}
+
+		if !strings.HasPrefix(arg, "--") {
+			positional = append(positional, arg)
+			continue
+		}
+
+		name, value, ok := strings.Cut(strings.TrimPrefix(arg, "--"), "=")
+
+		if !ok && i+1 < len(args) && !strings.HasPrefix(args[i+1], "--") {
+			value = args[i+1]
+			i++
+		}
+
+		flags[name] = value
+	}
+
+	return positional, flags
+}
+
 type cliCommand struct {
 	name        string
 	description string
 	callback    string
+	//Commands that change the trainer's state are followed by an autosave
+	mutates bool
 }
 
 func getCommands() map[string]cliCommand {
@@ -115,23 +317,36 @@ func getCommands() map[string]cliCommand {
 		},
 		"map": {
 			name:        "map",
-			description: "Displays the next 20 names of location areas in the Pokemon world",
+			description: "Displays the next 20 names of location areas in the Pokemon world. Use --region kanto to walk one region (--region all to go back) or --location viridian-forest for a single location",
 			callback:    "commandMap",
+			mutates:     true,
 		},
 		"mapb": {
 			name:        "mapb",
 			description: "Displays previous 20 locations",
 			callback:    "commandMapB",
+			mutates:     true,
 		},
 		"explore": {
 			name:        "explore",
-			description: "List of all the Pokémon in a given area",
+			description: "List of all the Pokémon in a given area with their levels and chances, optionally filtered with --version red and --method walk",
 			callback:    "commandExplore",
 		},
 		"catch": {
 			name:        "catch",
-			description: "Catching Pokemon adds them to the user's Pokedex. It takes the name of a Pokemon as an argument",
+			description: "Catching Pokemon adds them to the user's Pokedex. It takes the name of a Pokemon as an argument and uses a poke-ball unless another is picked with --ball ultra-ball",
 			callback:    "commandCatch",
+			mutates:     true,
+		},
+		"seed": {
+			name:        "seed",
+			description: "Print the seed of the random outcomes, or takes a number as an argument to start over from that seed",
+			callback:    "commandSeed",
+		},
+		"odds": {
+			name:        "odds",
+			description: "Takes the name of a Pokemon as an argument. Print the chance to catch it with a poke-ball or the ball picked with --ball ultra-ball",
+			callback:    "commandOdds",
 		},
 		"inspect": {
 			name:        "inspect",
@@ -143,6 +358,51 @@ func getCommands() map[string]cliCommand {
 			description: "Print a list of all the names of the Pokemon the user has caught",
 			callback:    "commandPokedex",
 		},
+		"evolutions": {
+			name:        "evolutions",
+			description: "Takes the name of a Pokemon species as an argument. Print its evolution chain and how each evolution happens",
+			callback:    "commandEvolutions",
+		},
+		"matchup": {
+			name:        "matchup",
+			description: "Takes an attacking type and a Pokemon or type[/type] as arguments. Print the damage multiplier",
+			callback:    "commandMatchup",
+		},
+		"weaknesses": {
+			name:        "weaknesses",
+			description: "Takes the name of a Pokemon as an argument. Print how much damage it takes from each attacking type",
+			callback:    "commandWeaknesses",
+		},
+		"moves": {
+			name:        "moves",
+			description: "Takes the name of a Pokemon as an argument. Print the moves it learns, optionally in a game with --version-group red-blue",
+			callback:    "commandMoves",
+		},
+		"move": {
+			name:        "move",
+			description: "Takes the name of a move as an argument. Print its type, power, accuracy, PP and effect",
+			callback:    "commandMove",
+		},
+		"ability": {
+			name:        "ability",
+			description: "Takes the name of an ability as an argument. Print its effect and which Pokemon can have it",
+			callback:    "commandAbility",
+		},
+		"where": {
+			name:        "where",
+			description: "Takes the name of a Pokemon as an argument. List the areas where it can be encountered, grouped by game version",
+			callback:    "commandWhere",
+		},
+		"bag": {
+			name:        "bag",
+			description: "Print the items in your bag",
+			callback:    "commandBag",
+		},
+		"item": {
+			name:        "item",
+			description: "Takes the name of an item as an argument. Print its category, cost and effect",
+			callback:    "commandItem",
+		},
 	}
 }
 
@@ -166,57 +426,103 @@ func getCommandsText(commands map[string]cliCommand) (string, error) {
 		return commandsText, errors.New("no commands to display")
 	}
 
-	for _, command := range commands {
+	names := make([]string, 0, len(commands))
+
+	for name := range commands {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		command := commands[name]
 		commandsText += fmt.Sprintf("%s: %s\n", command.name, command.description)
 	}
 
 	return commandsText, nil
 }
 
-func commandMap(conf *config, cache pokecache.Cache) error {
-	offset := conf.Next + 1
+func commandMap(ctx context.Context, conf *config, region string, location string, client *pokeapi.Client, cache *caches) error {
+	if location != "" {
+		return commandMapLocation(ctx, location, client, cache)
+	}
+
+	if region == "all" {
+		conf.Region = ""
+	} else if region != "" {
+		return showRegionPage(ctx, conf, region, 0, client, cache)
+	}
+
+	if conf.Region != "" {
+		return showRegionPage(ctx, conf, conf.Region, conf.RegionPage+1, client, cache)
+	}
+
+	if conf.Next == "" {
+		fmt.Println("You're on the last page")
+		return nil
+	}
 
-	toPrint, err := getNamesPage(offset, cache)
+	page, err := getNamesPage(ctx, conf.Next, client, cache)
 
 	if err == nil {
-		fmt.Print(toPrint)
+		printNamesPage(page)
 
-		conf.Previous = conf.Next
-		conf.Next = conf.Next + 20
+		conf.Next = page.Next
+		conf.Previous = page.Previous
 	} else {
-		fmt.Print(err)
+		printError(err)
 	}
 
 	return nil
 }
 
-func commandMapB(conf *config, cache pokecache.Cache) error {
-	if conf.Previous == 0 {
+func commandMapB(ctx context.Context, conf *config, client *pokeapi.Client, cache *caches) error {
+	if conf.Region != "" {
+		if conf.RegionPage <= 0 {
+			fmt.Println("No previous")
+			return nil
+		}
+
+		return showRegionPage(ctx, conf, conf.Region, conf.RegionPage-1, client, cache)
+	}
+
+	if conf.Previous == "" {
 		fmt.Println("No previous")
 	} else {
-		//Prepare offset but not affect config till data is fetched
-		offset := conf.Previous - 20 + 1
-
-		toPrint, err := getNamesPage(offset, cache)
+		page, err := getNamesPage(ctx, conf.Previous, client, cache)
 
 		if err == nil {
-			fmt.Print(toPrint)
+			printNamesPage(page)
 
-			conf.Next = conf.Previous
-			conf.Previous = conf.Previous - 20
+			conf.Next = page.Next
+			conf.Previous = page.Previous
 		} else {
-			fmt.Print(err)
+			printError(err)
 		}
 	}
 
 	return nil
 }
 
+func printNamesPage(page pokeapi.LocationAreaPage) {
+	for _, name := range page.Names {
+		fmt.Println(name)
+	}
+}
+
 func commandPokedex(pokedex map[string]pokeapi.PokemonToCatch) error {
 	if len(pokedex) > 0 {
 		fmt.Println("Your Pokedex:")
 
+		names := make([]string, 0, len(pokedex))
+
 		for pokemonName := range pokedex {
+			names = append(names, pokemonName)
+		}
+
+		sort.Strings(names)
+
+		for _, pokemonName := range names {
 			fmt.Printf("- %s\n", pokemonName)
 		}
 
@@ -237,12 +543,17 @@ func commandInspect(name string, pokedex map[string]pokeapi.PokemonToCatch) erro
 	}
 
 	fmt.Printf("Name: %s\n", pokemon.Name)
+
+	if pokemon.Genus != "" {
+		fmt.Printf("Genus: %s\n", pokemon.Genus)
+	}
+
 	fmt.Printf("Height: %v\n", pokemon.Height)
 	fmt.Printf("Weight: %v\n", pokemon.Weight)
 	fmt.Print("Stats:\n")
 
-	for name, value := range pokemon.Stats {
-		fmt.Printf("- %s: %v\n", name, value)
+	for _, name := range sortedStats(pokemon.Stats) {
+		fmt.Printf("- %s: %v\n", name, pokemon.Stats[name])
 	}
 
 	fmt.Print("Types:\n")
@@ -251,22 +562,92 @@ func commandInspect(name string, pokedex map[string]pokeapi.PokemonToCatch) erro
 		fmt.Printf("- %s\n", pType)
 	}
 
+	if len(pokemon.Abilities) > 0 {
+		fmt.Print("Abilities:\n")
+
+		for _, ability := range pokemon.Abilities {
+			if ability.IsHidden {
+				fmt.Printf("- %s (hidden)\n", ability.Name)
+			} else {
+				fmt.Printf("- %s\n", ability.Name)
+			}
+		}
+	}
+
+	if pokemon.FlavorText != "" {
+		fmt.Printf("\n%s\n", pokemon.FlavorText)
+	}
+
 	return nil
 }
 
-func commandCatch(name string, cache pokecache.Cache, pokedex map[string]pokeapi.PokemonToCatch) error {
-	fmt.Printf("Throwing a Pokeball at %s...\n", name)
+// Stats in the order the games show them, unknown stats go last by name
+func sortedStats(stats map[string]int) []string {
+	order := map[string]int{"hp": 1, "attack": 2, "defense": 3, "special-attack": 4, "special-defense": 5, "speed": 6}
+	names := make([]string, 0, len(stats))
+
+	for name := range stats {
+		names = append(names, name)
+	}
+
+	sort.Slice(names, func(i, j int) bool {
+		a, aKnown := order[names[i]]
+		b, bKnown := order[names[j]]
+
+		if aKnown && bKnown {
+			return a < b
+		}
+
+		if aKnown != bKnown {
+			return aKnown
+		}
+
+		return names[i] < names[j]
+	})
+
+	return names
+}
+
+func commandCatch(ctx context.Context, name string, ball string, client *pokeapi.Client, cache *caches, pokedex map[string]pokeapi.PokemonToCatch, items bag, rng *rand.Rand) error {
+	if ball == "" {
+		ball = defaultBall
+	}
+
+	ballBonus, err := getBallBonus(ctx, ball, client, cache)
+
+	if err != nil {
+		printError(err)
+		return nil
+	}
+
+	if items[ball] <= 0 {
+		fmt.Printf("You have no %s left\n", ball)
+		return nil
+	}
+
+	pokemon, err := getPokemonWithSpecies(ctx, name, client, cache)
 
-	pokemon, err := getPokemon(name, cache)
+	if errors.Is(err, pokeapi.ErrNotFound) {
+		fmt.Printf("No Pokémon named %s\n", name)
+		return nil
+	}
 
 	if err != nil {
-		fmt.Print(err)
+		printError(err)
 		return nil
 	}
 
-	catched := tryToCatch(pokemon.BaseExperience)
+	items[ball]--
+
+	fmt.Printf("Throwing a %s at %s...\n", ball, name)
+
+	result := pokecatch.Throw(catchAttempt(pokemon, ballBonus), rng)
+
+	for i := 0; i < min(result.Shakes, 3); i++ {
+		fmt.Println("...shake")
+	}
 
-	if catched {
+	if result.Caught {
 		fmt.Printf("%s was caught!\n", name)
 		pokedex[name] = pokemon
 	} else {
@@ -276,106 +657,109 @@ func commandCatch(name string, cache pokecache.Cache, pokedex map[string]pokeapi
 	return nil
 }
 
-func tryToCatch(baseExp int) bool {
-	scale, difficulty, adjust := 1000, 1, 10
-	catchChance := scale / ((difficulty * baseExp) + adjust)
-
-	diceThrow := rand.Intn(10)
-
-	return diceThrow <= catchChance
+func getPokemon(ctx context.Context, name string, client *pokeapi.Client, cache *caches) (pokeapi.PokemonToCatch, error) {
+	return getCached(cache.pokemon, name, func() (pokeapi.PokemonToCatch, error) {
+		return client.GetPokemonToCatchContext(ctx, name)
+	})
 }
 
-func getPokemon(name string, cache pokecache.Cache) (pokeapi.PokemonToCatch, error) {
-	cached, ok := cache.Get(name)
+// Catching needs the capture rate, and inspect the genus and flavor text
+func getPokemonWithSpecies(ctx context.Context, name string, client *pokeapi.Client, cache *caches) (pokeapi.PokemonToCatch, error) {
+	cacheKey := fmt.Sprintf("withSpecies_%s", name)
 
-	if ok {
-		pokemonToCatch := pokeapi.PokemonToCatch{}
+	return getCached(cache.pokemon, cacheKey, func() (pokeapi.PokemonToCatch, error) {
+		return client.GetPokemonToCatchWithSpeciesContext(ctx, name)
+	})
+}
 
-		json.Unmarshal(cached, &pokemonToCatch)
-		return pokemonToCatch, nil
-	} else {
-		pokemonToCatch, err := pokeapi.GetPokemonToCatch(name)
+func commandExplore(ctx context.Context, locationName string, filter pokeapi.EncounterFilter, client *pokeapi.Client, cache *caches) error {
+	fmt.Printf("Exploring %s...\n", locationName)
 
-		if err != nil {
-			return pokemonToCatch, err
-		} else {
-			toCache, err := json.Marshal(pokemonToCatch)
+	encounters, err := getAreaEncounters(ctx, locationName, filter, client, cache)
 
-			if err != nil {
-				return pokemonToCatch, err
-			}
+	if errors.Is(err, pokeapi.ErrNotFound) {
+		fmt.Printf("No location area named %s\n", locationName)
+		return nil
+	}
 
-			cache.Add(name, toCache)
+	if err != nil {
+		printError(err)
+		return nil
+	}
 
-			return pokemonToCatch, nil
-		}
+	if len(encounters) == 0 {
+		fmt.Println("No Pokemon found")
+		return nil
 	}
-}
 
-func commandExplore(locationName string, cache pokecache.Cache) error {
-	fmt.Printf("Exploring %s...\n", locationName)
+	fmt.Println("Found Pokemon:")
 
-	toPrint, err := getPokemonsInArea(locationName, cache)
+	pokemon := ""
 
-	if err == nil {
-		fmt.Println("Found Pokemon:")
-		fmt.Print(toPrint)
-	} else {
-		fmt.Print(err)
+	for _, encounter := range pokeapi.GroupEncounters(encounters) {
+		if encounter.Pokemon != pokemon {
+			pokemon = encounter.Pokemon
+			fmt.Printf("- %s\n", pokemon)
+		}
+
+		fmt.Printf("    %s, %s\n", encounter.Version, formatEncounter(encounter))
 	}
 
 	return nil
 }
 
-func getPokemonsInArea(locationName string, cache pokecache.Cache) (string, error) {
-	cacheKey := fmt.Sprintf("pokemonsInArea_%s", locationName)
-
-	cached, ok := cache.Get(cacheKey)
+func formatEncounter(encounter pokeapi.AreaEncounter) string {
+	levels := fmt.Sprintf("lv %d", encounter.MinLevel)
 
-	if ok {
-		return fmt.Sprint(cached), nil
-	} else {
-		names, err := pokeapi.GetPokemonsInArea(locationName)
+	if encounter.MaxLevel != encounter.MinLevel {
+		levels = fmt.Sprintf("lv %d-%d", encounter.MinLevel, encounter.MaxLevel)
+	}
 
-		if err != nil {
-			return "", err
-		} else {
-			namesLine := ""
-			for _, name := range names {
-				namesLine += fmt.Sprintf("%s\n", name)
-			}
-			cache.Add(cacheKey, []byte(namesLine))
+	text := fmt.Sprintf("%s: %s, %d%%", encounter.Method, levels, encounter.Chance)
 
-			return namesLine, nil
-		}
+	if len(encounter.Conditions) > 0 {
+		text += fmt.Sprintf(" [%s]", strings.Join(encounter.Conditions, ", "))
 	}
-}
 
-func getNamesPage(offset int, cache pokecache.Cache) (string, error) {
-	cacheKey := fmt.Sprintf("page%d-%d", offset, offset+20)
-
-	cached, ok := cache.Get(cacheKey)
+	return text
+}
 
-	if ok {
-		return fmt.Sprint(cached), nil
-	} else {
-		names, err := pokeapi.GetLocationAreaNames(20, offset)
+func getAreaEncounters(ctx context.Context, locationName string, filter pokeapi.EncounterFilter, client *pokeapi.Client, cache *caches) ([]pokeapi.AreaEncounter, error) {
+	cacheKey := fmt.Sprintf("areaEncounters_%s_%s_%s", locationName, filter.Version, filter.Method)
 
-		if err != nil {
-			return "", err
-		} else {
-			namesLine := ""
-			for _, name := range names {
-				namesLine += fmt.Sprintf("%s\n", name)
-			}
-			cache.Add(cacheKey, []byte(namesLine))
+	return getCached(cache.encounters, cacheKey, func() ([]pokeapi.AreaEncounter, error) {
+		return client.GetPokemonsInAreaContext(ctx, locationName, filter)
+	})
+}
 
-			return namesLine, nil
-		}
-	}
+func getNamesPage(ctx context.Context, pageURL string, client *pokeapi.Client, cache *caches) (pokeapi.LocationAreaPage, error) {
+	return getCached(cache.pages, pageURL, func() (pokeapi.LocationAreaPage, error) {
+		return client.GetLocationAreaPageContext(ctx, pageURL)
+	})
 }
 
 type config struct {
-	Next     int
-	Previous int
+	Next     string `json:"next"`
+	Previous string `json:"previous"`
+	//Set while map walks a single region, paged by its locations
+	Region     string `json:"region,omitempty"`
+	RegionPage int    `json:"region_page,omitempty"`
+}
+
+func printError(err error) {
+	var apiErr *pokeapi.APIError
+	var decodeErr *pokeapi.DecodeError
+
+	switch {
+	case errors.Is(err, context.Canceled):
+		fmt.Println("\nCancelled")
+	case errors.Is(err, pokeapi.ErrRateLimited):
+		fmt.Println("PokeAPI rate limit reached, try again in a moment")
+	case errors.As(err, &apiErr):
+		fmt.Printf("PokeAPI request failed with status %d\n", apiErr.StatusCode)
+	case errors.As(err, &decodeErr):
+		fmt.Printf("Unexpected response from PokeAPI: %v\n", decodeErr.Err)
+	default:
+		fmt.Println(err)
+	}
 }
@@ -0,0 +1,162 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/tenmoses/pokeapi"
+)
+
+func commandMatchup(ctx context.Context, attack string, defender string, client *pokeapi.Client, cache *caches) error {
+	attackType, err := getType(ctx, attack, client, cache)
+
+	if errors.Is(err, pokeapi.ErrNotFound) {
+		fmt.Printf("No type named %s\n", attack)
+		return nil
+	}
+
+	if err != nil {
+		printError(err)
+		return nil
+	}
+
+	defenderTypes, err := getDefenderTypes(ctx, defender, client, cache)
+
+	if err != nil {
+		printError(err)
+		return nil
+	}
+
+	chart := pokeapi.NewTypeChart(attackType)
+	multiplier := chart.Multiplier(attack, defenderTypes...)
+
+	defenderName := strings.Join(defenderTypes, "/")
+
+	if defenderName != defender {
+		defenderName = fmt.Sprintf("%s (%s)", defender, defenderName)
+	}
+
+	fmt.Printf("%s vs %s: %s, %s\n", attack, defenderName, formatMultiplier(multiplier), effectiveness(multiplier))
+
+	return nil
+}
+
+func commandWeaknesses(ctx context.Context, name string, client *pokeapi.Client, cache *caches) error {
+	pokemon, err := getPokemon(ctx, name, client, cache)
+
+	if errors.Is(err, pokeapi.ErrNotFound) {
+		fmt.Printf("No Pokémon named %s\n", name)
+		return nil
+	}
+
+	if err != nil {
+		printError(err)
+		return nil
+	}
+
+	chart := pokeapi.NewTypeChart()
+
+	for _, typeName := range pokemon.Types {
+		pType, err := getType(ctx, typeName, client, cache)
+
+		if err != nil {
+			printError(err)
+			return nil
+		}
+
+		chart.Add(pType)
+	}
+
+	weaknesses := chart.Weaknesses(pokemon.Types...)
+
+	//Group attacking types by multiplier, strongest first
+	byMultiplier := make(map[float64][]string)
+	multipliers := make([]float64, 0)
+
+	for attack, multiplier := range weaknesses {
+		if _, ok := byMultiplier[multiplier]; !ok {
+			multipliers = append(multipliers, multiplier)
+		}
+		byMultiplier[multiplier] = append(byMultiplier[multiplier], attack)
+	}
+
+	sort.Sort(sort.Reverse(sort.Float64Slice(multipliers)))
+
+	fmt.Printf("%s (%s) takes:\n", pokemon.Name, strings.Join(pokemon.Types, "/"))
+
+	for _, multiplier := range multipliers {
+		attacks := byMultiplier[multiplier]
+		sort.Strings(attacks)
+		fmt.Printf("- %s from %s\n", formatMultiplier(multiplier), strings.Join(attacks, ", "))
+	}
+
+	return nil
+}
+
+// Defender is either type[/type] or the name of a Pokemon
+func getDefenderTypes(ctx context.Context, defender string, client *pokeapi.Client, cache *caches) ([]string, error) {
+	typeNames := strings.Split(defender, "/")
+
+	if len(typeNames) == 1 {
+		_, err := getType(ctx, defender, client, cache)
+
+		if err == nil {
+			return typeNames, nil
+		}
+
+		if !errors.Is(err, pokeapi.ErrNotFound) {
+			return nil, err
+		}
+
+		pokemon, err := getPokemon(ctx, defender, client, cache)
+
+		if errors.Is(err, pokeapi.ErrNotFound) {
+			return nil, fmt.Errorf("No type or Pokémon named %s", defender)
+		}
+
+		return pokemon.Types, err
+	}
+
+	for _, typeName := range typeNames {
+		_, err := getType(ctx, typeName, client, cache)
+
+		if errors.Is(err, pokeapi.ErrNotFound) {
+			return nil, fmt.Errorf("No type named %s", typeName)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return typeNames, nil
+}
+
+func getType(ctx context.Context, name string, client *pokeapi.Client, cache *caches) (pokeapi.Type, error) {
+	cacheKey := fmt.Sprintf("type_%s", name)
+
+	return getCached(cache.types, cacheKey, func() (pokeapi.Type, error) {
+		return client.GetTypeContext(ctx, name)
+	})
+}
+
+func formatMultiplier(multiplier float64) string {
+	return strconv.FormatFloat(multiplier, 'g', -1, 64) + "x"
+}
+
+func effectiveness(multiplier float64) string {
+	switch {
+	case multiplier == 0:
+		return "no effect"
+	case multiplier < 1:
+		return "not very effective"
+	case multiplier > 1:
+		return "super effective"
+	}
+
+	return "normal damage"
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/tenmoses/pokeapi"
+)
+
+func commandMoves(ctx context.Context, name string, versionGroup string, client *pokeapi.Client, cache *caches) error {
+	learnset, err := getLearnset(ctx, name, versionGroup, client, cache)
+
+	if errors.Is(err, pokeapi.ErrNotFound) {
+		fmt.Printf("No Pokémon named %s\n", name)
+		return nil
+	}
+
+	if err != nil {
+		printError(err)
+		return nil
+	}
+
+	if len(learnset) == 0 {
+		if versionGroup == "" {
+			fmt.Printf("%s learns no moves\n", name)
+		} else {
+			fmt.Printf("%s learns no moves in %s\n", name, versionGroup)
+		}
+		return nil
+	}
+
+	fmt.Printf("Moves %s learns in %s:\n", name, learnset[0].VersionGroup)
+
+	method := ""
+
+	for _, entry := range learnset {
+		if entry.Method != method {
+			method = entry.Method
+			fmt.Printf("%s:\n", method)
+		}
+
+		if entry.Method == "level-up" {
+			fmt.Printf("- lv %d %s\n", entry.Level, entry.Move)
+		} else {
+			fmt.Printf("- %s\n", entry.Move)
+		}
+	}
+
+	return nil
+}
+
+func commandMove(ctx context.Context, name string, client *pokeapi.Client, cache *caches) error {
+	move, err := getMove(ctx, name, client, cache)
+
+	if errors.Is(err, pokeapi.ErrNotFound) {
+		fmt.Printf("No move named %s\n", name)
+		return nil
+	}
+
+	if err != nil {
+		printError(err)
+		return nil
+	}
+
+	fmt.Printf("Name: %s\n", move.Name)
+	fmt.Printf("Type: %s\n", move.Type.Name)
+	fmt.Printf("Class: %s\n", move.DamageClass.Name)
+	fmt.Printf("Power: %s\n", formatOptional(move.Power))
+	fmt.Printf("Accuracy: %s\n", formatOptional(move.Accuracy))
+	fmt.Printf("PP: %s\n", formatOptional(move.PP))
+	fmt.Printf("Priority: %d\n", move.Priority)
+
+	effect := move.Effect("en")
+
+	if effect != "" {
+		fmt.Printf("Effect: %s\n", effect)
+	}
+
+	return nil
+}
+
+func formatOptional(value *int) string {
+	if value == nil {
+		return "-"
+	}
+
+	return fmt.Sprint(*value)
+}
+
+func getLearnset(ctx context.Context, name string, versionGroup string, client *pokeapi.Client, cache *caches) ([]pokeapi.LearnsetEntry, error) {
+	cacheKey := fmt.Sprintf("learnset_%s_%s", name, versionGroup)
+
+	return getCached(cache.learnsets, cacheKey, func() ([]pokeapi.LearnsetEntry, error) {
+		return client.GetLearnsetContext(ctx, name, versionGroup)
+	})
+}
+
+func getMove(ctx context.Context, name string, client *pokeapi.Client, cache *caches) (pokeapi.Move, error) {
+	cacheKey := fmt.Sprintf("move_%s", name)
+
+	return getCached(cache.moves, cacheKey, func() (pokeapi.Move, error) {
+		return client.GetMoveContext(ctx, name)
+	})
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/tenmoses/pokeapi"
+)
+
+const locationsPerPage = 20
+
+// Prints the areas of one page of the region's locations, each with its parent location
+func showRegionPage(ctx context.Context, conf *config, regionName string, page int, client *pokeapi.Client, cache *caches) error {
+	region, err := getRegion(ctx, regionName, client, cache)
+
+	if errors.Is(err, pokeapi.ErrNotFound) {
+		fmt.Printf("No region named %s\n", regionName)
+		return nil
+	}
+
+	if err != nil {
+		printError(err)
+		return nil
+	}
+
+	start := page * locationsPerPage
+
+	if start >= len(region.Locations) {
+		fmt.Println("You're on the last page")
+		return nil
+	}
+
+	end := min(start+locationsPerPage, len(region.Locations))
+
+	areas := make([]string, 0)
+
+	for _, locationResource := range region.Locations[start:end] {
+		location, err := getLocation(ctx, locationResource.Name, client, cache)
+
+		if err != nil {
+			printError(err)
+			return nil
+		}
+
+		for _, area := range location.Areas {
+			areas = append(areas, fmt.Sprintf("%s (%s)", area.Name, location.Name))
+		}
+	}
+
+	fmt.Printf("%s, locations %d-%d of %d:\n", region.Name, start+1, end, len(region.Locations))
+
+	for _, area := range areas {
+		fmt.Println(area)
+	}
+
+	conf.Region = region.Name
+	conf.RegionPage = page
+
+	return nil
+}
+
+func commandMapLocation(ctx context.Context, locationName string, client *pokeapi.Client, cache *caches) error {
+	location, err := getLocation(ctx, locationName, client, cache)
+
+	if errors.Is(err, pokeapi.ErrNotFound) {
+		fmt.Printf("No location named %s\n", locationName)
+		return nil
+	}
+
+	if err != nil {
+		printError(err)
+		return nil
+	}
+
+	if len(location.Areas) == 0 {
+		fmt.Printf("%s has no location areas\n", location.Name)
+		return nil
+	}
+
+	for _, area := range location.Areas {
+		fmt.Printf("%s (%s)\n", area.Name, location.Name)
+	}
+
+	return nil
+}
+
+func getRegion(ctx context.Context, name string, client *pokeapi.Client, cache *caches) (pokeapi.Region, error) {
+	cacheKey := fmt.Sprintf("region_%s", name)
+
+	return getCached(cache.regions, cacheKey, func() (pokeapi.Region, error) {
+		return client.GetRegionContext(ctx, name)
+	})
+}
+
+func getLocation(ctx context.Context, name string, client *pokeapi.Client, cache *caches) (pokeapi.Location, error) {
+	cacheKey := fmt.Sprintf("location_%s", name)
+
+	return getCached(cache.locations, cacheKey, func() (pokeapi.Location, error) {
+		return client.GetLocationContext(ctx, name)
+	})
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strconv"
+)
+
+// Source of every random outcome in a session, the same seed and inputs give the same outcomes
+type seededRand struct {
+	*rand.Rand
+	source *rand.PCG
+	seed   uint64
+}
+
+func newSeededRand(seed uint64) *seededRand {
+	source := rand.NewPCG(seed, seed)
+
+	return &seededRand{
+		Rand:   rand.New(source),
+		source: source,
+		seed:   seed,
+	}
+}
+
+func (r *seededRand) Reseed(seed uint64) {
+	r.source.Seed(seed, seed)
+	r.seed = seed
+}
+
+func (r *seededRand) Seed() uint64 {
+	return r.seed
+}
+
+func commandSeed(args []string, rng *seededRand) error {
+	if len(args) == 0 {
+		fmt.Printf("Seed: %d\n", rng.Seed())
+		return nil
+	}
+
+	seed, err := strconv.ParseUint(args[0], 10, 64)
+
+	if err != nil {
+		fmt.Printf("Seed must be a non-negative number, got %s\n", args[0])
+		return nil
+	}
+
+	rng.Reseed(seed)
+	fmt.Printf("Seed set to %d\n", seed)
+
+	return nil
+}
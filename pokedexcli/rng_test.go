@@ -0,0 +1,56 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/tenmoses/pokecatch"
+)
+
+func throwSequence(rng *seededRand) []pokecatch.Result {
+	attempts := []pokecatch.Attempt{
+		{CaptureRate: 190, BallBonus: 1, MaxHP: 35, CurrentHP: 35},
+		{CaptureRate: 45, BallBonus: 1.5, MaxHP: 78, CurrentHP: 78},
+		{CaptureRate: 3, BallBonus: 2, MaxHP: 106, CurrentHP: 106},
+	}
+
+	results := make([]pokecatch.Result, 0)
+
+	for i := 0; i < 20; i++ {
+		for _, attempt := range attempts {
+			results = append(results, pokecatch.Throw(attempt, rng.Rand))
+		}
+	}
+
+	return results
+}
+
+func TestSameSeedGivesSameCatches(t *testing.T) {
+	first := throwSequence(newSeededRand(2024))
+	second := throwSequence(newSeededRand(2024))
+
+	if !reflect.DeepEqual(first, second) {
+		t.Errorf("expected identical catches for the same seed")
+	}
+
+	other := throwSequence(newSeededRand(2025))
+
+	if reflect.DeepEqual(first, other) {
+		t.Errorf("expected a different seed to give different catches")
+	}
+}
+
+func TestReseedRestartsTheSequence(t *testing.T) {
+	rng := newSeededRand(1)
+	throwSequence(rng)
+
+	rng.Reseed(2024)
+
+	if rng.Seed() != 2024 {
+		t.Errorf("expected seed 2024, got %d", rng.Seed())
+	}
+
+	if !reflect.DeepEqual(throwSequence(rng), throwSequence(newSeededRand(2024))) {
+		t.Errorf("expected a reseeded source to replay the catches of a new one")
+	}
+}
@@ -0,0 +1,313 @@
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/tenmoses/pokeapi"
+)
+
+const saveSchemaVersion = 2
+
+var errSaveCorrupted = errors.New("save file is corrupted")
+
+// Upgrades the raw state of a save by one schema version, keyed by the version it upgrades from
+var saveMigrations = map[int]func(json.RawMessage) (json.RawMessage, error){
+	1: migrateSaveV1,
+}
+
+// On disk format, the checksum covers the compacted state
+type saveEnvelope struct {
+	SchemaVersion int             `json:"schema_version"`
+	Checksum      string          `json:"checksum"`
+	State         json.RawMessage `json:"state"`
+}
+
+// Trainer state kept between sessions
+type saveState struct {
+	Pokedex map[string]pokeapi.PokemonToCatch `json:"pokedex"`
+	Bag     bag                               `json:"bag"`
+	Config  config                            `json:"config"`
+}
+
+func defaultSavePath() (string, error) {
+	dir, err := os.UserConfigDir()
+
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, "pokedex", "save.json"), nil
+}
+
+// A missing file is not an error, ok reports whether a save was found.
+// Saves of an older schema are backed up next to the file before they are migrated
+func loadSave(path string) (saveState, bool, error) {
+	data, err := os.ReadFile(path)
+
+	if errors.Is(err, fs.ErrNotExist) {
+		return saveState{}, false, nil
+	}
+
+	if err != nil {
+		return saveState{}, false, err
+	}
+
+	version, state, err := decodeSave(data)
+
+	if err != nil {
+		return saveState{}, false, fmt.Errorf("%s: %w", path, err)
+	}
+
+	if version > saveSchemaVersion {
+		return saveState{}, false, fmt.Errorf("%s: schema version %d is newer than the supported %d", path, version, saveSchemaVersion)
+	}
+
+	if version < saveSchemaVersion {
+		backupPath := fmt.Sprintf("%s.v%d.bak", path, version)
+		err = writeFileAtomic(backupPath, data)
+
+		if err != nil {
+			return saveState{}, false, fmt.Errorf("backing up %s before migrating: %w", path, err)
+		}
+
+		state, err = migrateSave(version, state)
+
+		if err != nil {
+			return saveState{}, false, fmt.Errorf("%s: %w", path, err)
+		}
+	}
+
+	save := saveState{}
+	err = json.Unmarshal(state, &save)
+
+	if err != nil {
+		return saveState{}, false, fmt.Errorf("%s: %w: %v", path, errSaveCorrupted, err)
+	}
+
+	if save.Pokedex == nil {
+		save.Pokedex = make(map[string]pokeapi.PokemonToCatch)
+	}
+
+	if save.Bag == nil {
+		save.Bag = newBag()
+	}
+
+	if version < saveSchemaVersion {
+		err = writeSave(path, save)
+
+		if err != nil {
+			return saveState{}, false, err
+		}
+	}
+
+	return save, true, nil
+}
+
+// Page links of a save made against another base URL are rebuilt from
+// their limit and offset, so map keeps its place without going back to
+// the old host. Links that can't be rebuilt restart map from the first page
+func rebaseConfig(conf config, client *pokeapi.Client) config {
+	next, ok := rebasePageURL(conf.Next, client)
+
+	if !ok {
+		conf.Next = client.LocationAreaPageURL(20, 0)
+		conf.Previous = ""
+
+		return conf
+	}
+
+	previous, ok := rebasePageURL(conf.Previous, client)
+
+	if !ok {
+		previous = ""
+	}
+
+	conf.Next, conf.Previous = next, previous
+
+	return conf
+}
+
+func rebasePageURL(pageURL string, client *pokeapi.Client) (string, bool) {
+	if pageURL == "" || strings.HasPrefix(pageURL, client.BaseURL()+"/") {
+		return pageURL, true
+	}
+
+	parsed, err := url.Parse(pageURL)
+
+	if err != nil {
+		return "", false
+	}
+
+	limit, err := strconv.Atoi(parsed.Query().Get("limit"))
+
+	if err != nil {
+		return "", false
+	}
+
+	offset, err := strconv.Atoi(parsed.Query().Get("offset"))
+
+	if err != nil {
+		return "", false
+	}
+
+	return client.LocationAreaPageURL(limit, offset), true
+}
+
+// Schema version and raw state of a save, checked against its checksum
+func decodeSave(data []byte) (int, json.RawMessage, error) {
+	header := struct {
+		saveEnvelope
+		//Version 1 saves had no envelope, only this field next to the state
+		Version int `json:"version"`
+	}{}
+
+	err := json.Unmarshal(data, &header)
+
+	if err != nil {
+		return 0, nil, fmt.Errorf("%w: %v", errSaveCorrupted, err)
+	}
+
+	if header.SchemaVersion == 0 {
+		if header.Version == 1 {
+			return 1, data, nil
+		}
+
+		return 0, nil, fmt.Errorf("%w: no schema version", errSaveCorrupted)
+	}
+
+	if header.SchemaVersion > saveSchemaVersion {
+		return header.SchemaVersion, nil, nil
+	}
+
+	checksum, err := stateChecksum(header.State)
+
+	if err != nil {
+		return 0, nil, fmt.Errorf("%w: %v", errSaveCorrupted, err)
+	}
+
+	if checksum != header.Checksum {
+		return 0, nil, fmt.Errorf("%w: checksum mismatch", errSaveCorrupted)
+	}
+
+	return header.SchemaVersion, header.State, nil
+}
+
+func migrateSave(version int, state json.RawMessage) (json.RawMessage, error) {
+	for ; version < saveSchemaVersion; version++ {
+		migrate, ok := saveMigrations[version]
+
+		if !ok {
+			return nil, fmt.Errorf("no migration from schema version %d", version)
+		}
+
+		var err error
+		state, err = migrate(state)
+
+		if err != nil {
+			return nil, fmt.Errorf("migrating from schema version %d: %w", version, err)
+		}
+	}
+
+	return state, nil
+}
+
+// Version 1 kept the state next to its version field, version 2 moved it into the envelope
+func migrateSaveV1(state json.RawMessage) (json.RawMessage, error) {
+	fields := map[string]json.RawMessage{}
+	err := json.Unmarshal(state, &fields)
+
+	if err != nil {
+		return nil, err
+	}
+
+	delete(fields, "version")
+
+	return json.Marshal(fields)
+}
+
+func stateChecksum(state json.RawMessage) (string, error) {
+	compact := bytes.Buffer{}
+	err := json.Compact(&compact, state)
+
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(compact.Bytes())
+
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func writeSave(path string, save saveState) error {
+	state, err := json.Marshal(save)
+
+	if err != nil {
+		return err
+	}
+
+	checksum, err := stateChecksum(state)
+
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(saveEnvelope{
+		SchemaVersion: saveSchemaVersion,
+		Checksum:      checksum,
+		State:         state,
+	}, "", "  ")
+
+	if err != nil {
+		return err
+	}
+
+	return writeFileAtomic(path, data)
+}
+
+// Written to a temporary file first so a crash never leaves a half-written file
+func writeFileAtomic(path string, data []byte) error {
+	dir := filepath.Dir(path)
+	err := os.MkdirAll(dir, 0o755)
+
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(dir, filepath.Base(path)+".*.tmp")
+
+	if err != nil {
+		return err
+	}
+
+	//Removing fails harmlessly once the file has been renamed
+	defer os.Remove(tmp.Name())
+
+	_, err = tmp.Write(data)
+
+	if err == nil {
+		err = tmp.Sync()
+	}
+
+	closeErr := tmp.Close()
+
+	if err != nil {
+		return err
+	}
+
+	if closeErr != nil {
+		return closeErr
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,200 @@
+package main
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/tenmoses/pokeapi"
+)
+
+func TestSaveRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "pokedex", "save.json")
+	want := saveState{
+		Pokedex: map[string]pokeapi.PokemonToCatch{
+			"pikachu": {Name: "pikachu", Stats: map[string]int{"hp": 35}, Types: []string{"electric"}, CaptureRate: 190},
+		},
+		Bag:    bag{"poke-ball": 19},
+		Config: config{Next: "http://mirror/location-area/?offset=20&limit=20", Region: "kanto", RegionPage: 1},
+	}
+
+	err := writeSave(path, want)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got, ok, err := loadSave(path)
+	if err != nil || !ok {
+		t.Fatalf("expected a save, got ok %v error %v", ok, err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %+v, got %+v", want, got)
+	}
+}
+
+func TestLoadSaveMissingFile(t *testing.T) {
+	_, ok, err := loadSave(filepath.Join(t.TempDir(), "save.json"))
+
+	if err != nil || ok {
+		t.Errorf("expected no save and no error, got ok %v error %v", ok, err)
+	}
+}
+
+func TestLoadSaveDetectsCorruption(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "save.json")
+
+	err := writeSave(path, saveState{Bag: bag{"poke-ball": 1}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	data, _ := os.ReadFile(path)
+	os.WriteFile(path, []byte(strings.Replace(string(data), `"poke-ball": 1`, `"poke-ball": 99`, 1)), 0o644)
+
+	tampered, _ := os.ReadFile(path)
+	if string(tampered) == string(data) {
+		t.Fatal("test did not modify the save")
+	}
+
+	_, _, err = loadSave(path)
+	if !errors.Is(err, errSaveCorrupted) {
+		t.Errorf("expected errSaveCorrupted for a tampered save, got %v", err)
+	}
+
+	os.WriteFile(path, []byte(`{"schema_version": 2, "state": `), 0o644)
+
+	_, _, err = loadSave(path)
+	if !errors.Is(err, errSaveCorrupted) {
+		t.Errorf("expected errSaveCorrupted for truncated JSON, got %v", err)
+	}
+}
+
+func TestLoadSaveMigratesVersion1(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "save.json")
+	v1 := `{"version": 1, "pokedex": {"eevee": {"Name": "eevee", "CaptureRate": 45}}, "bag": {"ultra-ball": 2}, "config": {"next": "n", "previous": "p"}}`
+
+	os.WriteFile(path, []byte(v1), 0o644)
+
+	got, ok, err := loadSave(path)
+	if err != nil || !ok {
+		t.Fatalf("expected a save, got ok %v error %v", ok, err)
+	}
+
+	if got.Pokedex["eevee"].CaptureRate != 45 || got.Bag["ultra-ball"] != 2 || got.Config.Previous != "p" {
+		t.Errorf("unexpected migrated save: %+v", got)
+	}
+
+	backup, err := os.ReadFile(path + ".v1.bak")
+	if err != nil || string(backup) != v1 {
+		t.Errorf("expected the original save as backup, got %q error %v", backup, err)
+	}
+
+	data, _ := os.ReadFile(path)
+	if !strings.Contains(string(data), `"schema_version": 2`) {
+		t.Errorf("expected the save to be rewritten at schema version 2, got %s", data)
+	}
+}
+
+func TestLoadSaveRejectsNewerVersion(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "save.json")
+
+	os.WriteFile(path, []byte(`{"schema_version": 99, "checksum": "", "state": {}}`), 0o644)
+
+	_, _, err := loadSave(path)
+	if err == nil || !strings.Contains(err.Error(), "newer") {
+		t.Errorf("expected an error about a newer schema version, got %v", err)
+	}
+}
+
+func TestWriteFileAtomic(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "save.json")
+
+	os.WriteFile(path, []byte("old"), 0o644)
+
+	err := writeFileAtomic(path, []byte("new"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	data, _ := os.ReadFile(path)
+	if string(data) != "new" {
+		t.Errorf("expected the file to be replaced, got %q", data)
+	}
+
+	//Renaming onto a directory fails after the temporary file was written
+	blocked := filepath.Join(dir, "blocked")
+	os.MkdirAll(filepath.Join(blocked, "child"), 0o755)
+
+	err = writeFileAtomic(blocked, []byte("new"))
+	if err == nil {
+		t.Errorf("expected an error when the file can't be replaced")
+	}
+
+	temps, _ := filepath.Glob(filepath.Join(dir, "*.tmp"))
+	if len(temps) != 0 {
+		t.Errorf("expected no temporary files to be left behind, got %v", temps)
+	}
+}
+
+func TestLoadSaveFillsMissingState(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "save.json")
+
+	err := writeSave(path, saveState{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got, ok, err := loadSave(path)
+	if err != nil || !ok {
+		t.Fatalf("expected a save, got ok %v error %v", ok, err)
+	}
+
+	if got.Pokedex == nil || !reflect.DeepEqual(got.Bag, newBag()) {
+		t.Errorf("expected an empty Pokedex and a new bag, got %+v", got)
+	}
+}
+
+func TestRebaseConfigDropsTheOldHost(t *testing.T) {
+	client := pokeapi.NewClient(pokeapi.WithBaseURL("http://mirror/api/v2"))
+
+	cases := []struct {
+		name  string
+		saved config
+		want  config
+	}{
+		{
+			name:  "keeps links of the same base URL",
+			saved: config{Next: "http://mirror/api/v2/location-area/?offset=40&limit=20", Previous: "http://mirror/api/v2/location-area/?offset=0&limit=20"},
+			want:  config{Next: "http://mirror/api/v2/location-area/?offset=40&limit=20", Previous: "http://mirror/api/v2/location-area/?offset=0&limit=20"},
+		},
+		{
+			name:  "rebuilds links of another base URL",
+			saved: config{Next: "https://pokeapi.co/api/v2/location-area/?offset=40&limit=20", Previous: "https://pokeapi.co/api/v2/location-area/?offset=0&limit=20", Region: "kanto"},
+			want:  config{Next: client.LocationAreaPageURL(20, 40), Previous: client.LocationAreaPageURL(20, 0), Region: "kanto"},
+		},
+		{
+			name:  "keeps the end of the list",
+			saved: config{Next: "", Previous: "https://pokeapi.co/api/v2/location-area/?offset=1020&limit=20"},
+			want:  config{Next: "", Previous: client.LocationAreaPageURL(20, 1020)},
+		},
+		{
+			name:  "restarts from links it can't rebuild",
+			saved: config{Next: "https://pokeapi.co/api/v2/location-area/", Previous: "https://pokeapi.co/api/v2/location-area/?offset=0&limit=20"},
+			want:  config{Next: client.LocationAreaPageURL(20, 0), Previous: ""},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := rebaseConfig(c.saved, client)
+			if got != c.want {
+				t.Errorf("expected %+v, got %+v", c.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/tenmoses/pokeapi"
+)
+
+func commandWhere(ctx context.Context, name string, filter pokeapi.EncounterFilter, client *pokeapi.Client, cache *caches) error {
+	encounters, err := getPokemonEncounters(ctx, name, filter, client, cache)
+
+	if errors.Is(err, pokeapi.ErrNotFound) {
+		fmt.Printf("No Pokémon named %s\n", name)
+		return nil
+	}
+
+	if err != nil {
+		printError(err)
+		return nil
+	}
+
+	if len(encounters) == 0 {
+		fmt.Printf("%s can't be found in the wild\n", name)
+		return nil
+	}
+
+	//Group areas by game version, versions in the order they first appear
+	versions := make([]string, 0)
+	byVersion := make(map[string][]pokeapi.AreaEncounter)
+
+	for _, encounter := range pokeapi.GroupEncounters(encounters) {
+		if _, ok := byVersion[encounter.Version]; !ok {
+			versions = append(versions, encounter.Version)
+		}
+		byVersion[encounter.Version] = append(byVersion[encounter.Version], encounter)
+	}
+
+	fmt.Printf("Where to find %s:\n", name)
+
+	for _, version := range versions {
+		fmt.Printf("%s:\n", version)
+
+		for _, encounter := range byVersion[version] {
+			fmt.Printf("- %s, %s\n", encounter.Area, formatEncounter(encounter))
+		}
+	}
+
+	return nil
+}
+
+func getPokemonEncounters(ctx context.Context, name string, filter pokeapi.EncounterFilter, client *pokeapi.Client, cache *caches) ([]pokeapi.AreaEncounter, error) {
+	cacheKey := fmt.Sprintf("pokemonEncounters_%s_%s_%s", name, filter.Version, filter.Method)
+
+	return getCached(cache.encounters, cacheKey, func() ([]pokeapi.AreaEncounter, error) {
+		return client.GetPokemonEncountersContext(ctx, name, filter)
+	})
+}